@@ -29,6 +29,7 @@ var (
 	dataFiles   string
 	dataDirs    string
 	compact     bool
+	publicOnly  bool
 )
 
 // rootCmd 根命令
@@ -46,8 +47,12 @@ var rootCmd = &cobra.Command{
 var generateCmd = &cobra.Command{
 	Use:   "generate",
 	Short: "生成项目上下文文件",
-	Long:  `扫描指定项目目录，解析代码文件，并生成 code-outline.json 文件。`,
-	RunE:  runGenerate,
+	Long: `扫描指定项目目录，解析代码文件，并生成 code-outline.json 文件。
+
+YAML/JSON/TOML 配置和数据文件：超过 256KB 的文件不生成大纲，每个文件最多 200 个符号，
+整个项目最多 2000 个符号（按目录层级由浅到深计入，超出的文件只保留文件用途）。
+包管理器的锁文件（如 package-lock.json、yarn.lock）和输出文件本身不会被扫描。`,
+	RunE: runGenerate,
 }
 
 // updateCmd 更新命令
@@ -77,6 +82,7 @@ func init() {
 	generateCmd.Flags().StringVarP(&outputPath, "output", "o", "code-outline.json", "输出文件路径")
 	generateCmd.Flags().StringVarP(&excludeDirs, "exclude", "e", "", "要排除的目录或文件模式，用逗号分隔")
 	generateCmd.Flags().BoolVarP(&compact, "compact", "c", false, "生成紧凑的JSON输出（去掉所有空格）")
+	generateCmd.Flags().BoolVar(&publicOnly, "public-only", false, "只输出公开的符号（public、exported 或没有可见性概念的符号）")
 
 	// 添加update命令行参数
 	updateCmd.Flags().StringVarP(&projectPath, "path", "p", ".", "项目路径")
@@ -93,6 +99,7 @@ func init() {
 	queryCmd.Flags().StringVarP(&excludeDirs, "exclude", "e", "", "要排除的目录或文件模式，用逗号分隔")
 	queryCmd.Flags().StringVarP(&dataFiles, "files", "f", "", "指定要查询的文件，用逗号分隔（如：file1.go,file2.js）")
 	queryCmd.Flags().StringVarP(&dataDirs, "dirs", "d", "", "指定要查询的目录，用逗号分隔（如：src/,internal/）")
+	queryCmd.Flags().BoolVar(&publicOnly, "public-only", false, "只输出公开的符号（public、exported 或没有可见性概念的符号）")
 }
 
 // Execute 执行根命令
@@ -147,6 +154,7 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	// 4. 创建扫描器并扫描项目
 	fmt.Printf("🔍 扫描项目: %s\n", projectPath)
 	fileScanner := scanner.NewScanner(codeParser, excludePatterns)
+	fileScanner.SetOutputFile(resolveOutputPath(outputPath, projectPath))
 	files, techStack, err := fileScanner.ScanProject(projectPath)
 	if err != nil {
 		return fmt.Errorf("扫描项目失败: %w", err)
@@ -174,6 +182,9 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		}
 		relativeFiles[relPath] = fileInfo
 	}
+	if publicOnly {
+		relativeFiles = filterPublicFiles(relativeFiles)
+	}
 
 	// 获取项目根目录的绝对路径
 	absProjectPath, err := filepath.Abs(projectPath)
@@ -494,6 +505,13 @@ func runQuery(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("提取数据失败: %w", err)
 	}
+	if publicOnly {
+		dataResult.Files = filterPublicFiles(dataResult.Files)
+		dataResult.Stats.TotalSymbols = 0
+		for _, fileInfo := range dataResult.Files {
+			dataResult.Stats.TotalSymbols += len(fileInfo.Symbols)
+		}
+	}
 
 	// 5. 输出结果
 	if outputPath != "" {
@@ -681,6 +699,30 @@ func extractDataFromContext(context *models.ProjectContext, targetFiles, targetD
 	return result, nil
 }
 
+// filterPublicFiles 返回只保留公开符号的文件信息，用于生成纯 API 表面的大纲
+func filterPublicFiles(files map[string]models.FileInfo) map[string]models.FileInfo {
+	filtered := make(map[string]models.FileInfo, len(files))
+	for filePath, fileInfo := range files {
+		fileInfo.Symbols = filterPublicSymbols(fileInfo.Symbols)
+		filtered[filePath] = fileInfo
+	}
+	return filtered
+}
+
+// filterPublicSymbols 递归过滤符号及其方法和子符号，没有可见性的符号（语言没有可见性概念）保留
+func filterPublicSymbols(symbols []models.Symbol) []models.Symbol {
+	var public []models.Symbol
+	for _, symbol := range symbols {
+		if symbol.Visibility != "" && symbol.Visibility != models.VisibilityPublic && symbol.Visibility != models.VisibilityExported {
+			continue
+		}
+		symbol.Methods = filterPublicSymbols(symbol.Methods)
+		symbol.Children = filterPublicSymbols(symbol.Children)
+		public = append(public, symbol)
+	}
+	return public
+}
+
 // resolveOutputPath 解析输出路径，如果输出路径是相对路径，则相对于项目路径
 func resolveOutputPath(outputPath, projectPath string) string {
 	// 如果是绝对路径，直接返回
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+func TestFilterPublicSymbols(t *testing.T) {
+	symbols := []models.Symbol{
+		{
+			Prototype:  "public class UserService",
+			Visibility: models.VisibilityPublic,
+			Methods: []models.Symbol{
+				{Prototype: "public User find(int id)", Visibility: models.VisibilityPublic},
+				{Prototype: "private void load()", Visibility: models.VisibilityPrivate},
+				{Prototype: "protected void save()", Visibility: models.VisibilityProtected},
+			},
+			Children: []models.Symbol{
+				{
+					Prototype:  "public static class Builder",
+					Visibility: models.VisibilityPublic,
+					Methods: []models.Symbol{
+						{Prototype: "public Builder name(String name)", Visibility: models.VisibilityPublic},
+						{Prototype: "Builder reset()", Visibility: models.VisibilityPackage},
+					},
+				},
+				{Prototype: "private static class Cache", Visibility: models.VisibilityPrivate},
+			},
+		},
+		{Prototype: "internal class Helper", Visibility: models.VisibilityInternal},
+		{Prototype: "func Parse(text string) error", Visibility: models.VisibilityExported},
+		// 没有可见性概念的语言（如 Lua、Shell）的符号保留
+		{Prototype: "function M.setup(opts)"},
+	}
+
+	public := filterPublicSymbols(symbols)
+
+	var prototypes []string
+	for _, symbol := range public {
+		prototypes = append(prototypes, symbol.Prototype)
+	}
+	assert.Equal(t, []string{"public class UserService", "func Parse(text string) error", "function M.setup(opts)"}, prototypes)
+
+	service := public[0]
+	if assert.Len(t, service.Methods, 1) {
+		assert.Equal(t, "public User find(int id)", service.Methods[0].Prototype)
+	}
+	if assert.Len(t, service.Children, 1) {
+		builder := service.Children[0]
+		assert.Equal(t, "public static class Builder", builder.Prototype)
+		if assert.Len(t, builder.Methods, 1, "嵌套类型的方法同样过滤") {
+			assert.Equal(t, "public Builder name(String name)", builder.Methods[0].Prototype)
+		}
+	}
+
+	// 过滤不修改原始符号
+	assert.Len(t, symbols[0].Methods, 3)
+	assert.Len(t, symbols[0].Children, 2)
+}
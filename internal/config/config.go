@@ -1,6 +1,9 @@
 package config
 
 import (
+	"path/filepath"
+	"strings"
+
 	"github.com/cnwinds/code-outline/internal/models"
 )
 
@@ -42,6 +45,69 @@ func GetDefaultLanguagesConfig() models.LanguagesConfig {
 		"python": {
 			Extensions: []string{".py"},
 		},
+		"lua": {
+			Extensions: []string{".lua"},
+		},
+		"shell": {
+			Extensions: []string{".sh", ".bash", ".zsh"},
+		},
+		"sql": {
+			Extensions: []string{".sql"},
+		},
+		"protobuf": {
+			Extensions: []string{".proto"},
+		},
+		"vue": {
+			Extensions: []string{".vue"},
+		},
+		"svelte": {
+			Extensions: []string{".svelte"},
+		},
+		"hcl": {
+			Extensions: []string{".tf", ".hcl"},
+		},
+		"yaml": {
+			Extensions: []string{".yaml", ".yml"},
+		},
+		"json": {
+			Extensions: []string{".json"},
+		},
+		"toml": {
+			Extensions: []string{".toml"},
+		},
+		"markdown": {
+			Extensions: []string{".md", ".markdown"},
+		},
+		"dart": {
+			Extensions: []string{".dart"},
+		},
+		"elixir": {
+			Extensions: []string{".ex", ".exs"},
+		},
+		"erlang": {
+			Extensions: []string{".erl", ".hrl"},
+		},
+		"zig": {
+			Extensions: []string{".zig"},
+		},
+		"haskell": {
+			Extensions: []string{".hs"},
+		},
+		"ocaml": {
+			Extensions: []string{".ml", ".mli"},
+		},
+		"objc": {
+			Extensions: []string{".m", ".mm"},
+		},
+		"dockerfile": {
+			Extensions: []string{".dockerfile"},
+		},
+		"make": {
+			Extensions: []string{".mk"},
+		},
+		"cmake": {
+			Extensions: []string{".cmake"},
+		},
 	}
 }
 
@@ -56,3 +122,53 @@ func GetLanguageByExtension(config models.LanguagesConfig, ext string) (string,
 	}
 	return "", models.LanguageConfig{}, false
 }
+
+// shebangInterpreters shebang 解释器到语言名称的映射
+var shebangInterpreters = map[string]string{
+	"sh":         "shell",
+	"bash":       "shell",
+	"zsh":        "shell",
+	"dash":       "shell",
+	"ksh":        "shell",
+	"python":     "python",
+	"node":       "javascript",
+	"nodejs":     "javascript",
+	"ts-node":    "typescript",
+	"lua":        "lua",
+	"luajit":     "lua",
+	"elixir":     "elixir",
+	"escript":    "erlang",
+	"runghc":     "haskell",
+	"runhaskell": "haskell",
+	"ocaml":      "ocaml",
+	"make":       "make",
+}
+
+// GetLanguageByShebang 根据脚本首行的 shebang（如 #!/bin/bash、#!/usr/bin/env zsh）获取语言名称
+func GetLanguageByShebang(firstLine string) (string, bool) {
+	line := strings.TrimSpace(firstLine)
+	if !strings.HasPrefix(line, "#!") {
+		return "", false
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(line, "#!"))
+	if len(fields) == 0 {
+		return "", false
+	}
+
+	// 处理 /usr/bin/env [-S] interpreter 形式
+	interpreter := filepath.Base(fields[0])
+	if interpreter == "env" {
+		interpreter = ""
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "-") {
+				interpreter = filepath.Base(field)
+				break
+			}
+		}
+	}
+
+	// 去除版本号，如 python3.11
+	langName, exists := shebangInterpreters[strings.TrimRight(interpreter, "0123456789.")]
+	return langName, exists
+}
@@ -50,7 +50,7 @@ func TestGetDefaultLanguagesConfigAllLanguages(t *testing.T) {
 
 	expectedLanguages := []string{
 		"go", "java", "csharp", "cpp", "c", "rust",
-		"javascript", "typescript", "python",
+		"javascript", "typescript", "python", "lua", "shell", "sql", "protobuf", "vue", "svelte", "hcl", "yaml", "json", "toml", "markdown", "dart", "elixir", "erlang", "zig", "haskell", "ocaml", "objc", "dockerfile", "make", "cmake",
 	}
 
 	for _, lang := range expectedLanguages {
@@ -133,3 +133,30 @@ func TestConfigStruct(t *testing.T) {
 	assert.Equal(t, []string{"node_modules"}, config.Exclude)
 	assert.Equal(t, "/path/to/project", config.ProjectPath)
 }
+
+func TestGetLanguageByShebang(t *testing.T) {
+	testCases := []struct {
+		line     string
+		expected string
+		found    bool
+	}{
+		{"#!/bin/bash", "shell", true},
+		{"#!/bin/sh -e", "shell", true},
+		{"#!/usr/bin/env zsh", "shell", true},
+		{"#!/usr/bin/env -S bash -x", "shell", true},
+		{"#!/usr/bin/env python3", "python", true},
+		{"#!/usr/bin/python3.11", "python", true},
+		{"#!/usr/bin/env node", "javascript", true},
+		{"#!/usr/bin/env ruby", "", false},
+		{"# just a comment", "", false},
+		{"", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.line, func(t *testing.T) {
+			langName, found := GetLanguageByShebang(tc.line)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, langName)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+// filenameLanguages 按文件名识别语言（没有扩展名或扩展名不表示语言的文件）
+var filenameLanguages = map[string]string{
+	"Dockerfile":     "dockerfile",
+	"Containerfile":  "dockerfile",
+	"Makefile":       "make",
+	"makefile":       "make",
+	"GNUmakefile":    "make",
+	"CMakeLists.txt": "cmake",
+	// Bazel 使用的 Starlark 是 Python 的子集
+	"BUILD":           "python",
+	"BUILD.bazel":     "python",
+	"WORKSPACE":       "python",
+	"WORKSPACE.bazel": "python",
+}
+
+// modeAliases 编辑器模式行中的文件类型名称到语言名称的映射
+var modeAliases = map[string]string{
+	"c":            "c",
+	"c++":          "cpp",
+	"cpp":          "cpp",
+	"objc":         "objc",
+	"objective-c":  "objc",
+	"cs":           "csharp",
+	"csharp":       "csharp",
+	"go":           "go",
+	"rust":         "rust",
+	"java":         "java",
+	"python":       "python",
+	"py":           "python",
+	"javascript":   "javascript",
+	"js":           "javascript",
+	"typescript":   "typescript",
+	"ts":           "typescript",
+	"lua":          "lua",
+	"sh":           "shell",
+	"bash":         "shell",
+	"zsh":          "shell",
+	"shell-script": "shell",
+	"sql":          "sql",
+	"proto":        "protobuf",
+	"protobuf":     "protobuf",
+	"terraform":    "hcl",
+	"hcl":          "hcl",
+	"yaml":         "yaml",
+	"json":         "json",
+	"toml":         "toml",
+	"markdown":     "markdown",
+	"dart":         "dart",
+	"elixir":       "elixir",
+	"erlang":       "erlang",
+	"zig":          "zig",
+	"haskell":      "haskell",
+	"ocaml":        "ocaml",
+	"tuareg":       "ocaml",
+	"dockerfile":   "dockerfile",
+	"make":         "make",
+	"makefile":     "make",
+	"cmake":        "cmake",
+}
+
+var (
+	// vimModelineRegex 匹配 vim 模式行中的文件类型，如 vim: set ft=python:
+	vimModelineRegex = regexp.MustCompile(`(?:^|\s)(?:vim?|ex):.*\b(?:ft|filetype|syntax)=([\w+#-]+)`)
+
+	// emacsModelineRegex 匹配 emacs 模式行，如 -*- mode: python -*- 或 -*- C++ -*-
+	emacsModelineRegex = regexp.MustCompile(`-\*-\s*(.*?)\s*-\*-`)
+
+	// emacsModeRegex 匹配 emacs 模式行中的 mode 变量
+	emacsModeRegex = regexp.MustCompile(`(?i)\bmode\s*:\s*([\w+#-]+)`)
+
+	// objectiveCHeaderRegex 匹配 Objective-C 特有的指令和宏
+	objectiveCHeaderRegex = regexp.MustCompile(`(?m)^\s*(@interface|@protocol|@class|@import|#import)\b|\bNS_ASSUME_NONNULL_BEGIN\b`)
+
+	// cppHeaderRegex 匹配 C++ 特有的关键字和标准库用法
+	cppHeaderRegex = regexp.MustCompile(`(?m)^\s*(namespace\s+\w+|template\s*<|class\s+\w+[^;]*\{|(public|private|protected)\s*:|using\s+namespace\b)|\bstd::|#include\s*<(iostream|string|vector|memory|map|unordered_map|functional|algorithm)>`)
+)
+
+// modelineScanLines 检查模式行时扫描的文件开头和结尾行数
+const modelineScanLines = 5
+
+// DetectLanguage 依次根据文件名、扩展名（.h 头文件结合模式行和内容判断）、编辑器模式行和 shebang 检测文件语言，
+// 只返回 languages 中已配置的语言；已配置的扩展名不会被模式行覆盖
+func DetectLanguage(languages models.LanguagesConfig, filePath string, content []byte) (string, bool) {
+	configured := func(langName string) bool {
+		_, exists := languages[langName]
+		return langName != "" && exists
+	}
+
+	// 文件名规则
+	base := filepath.Base(filePath)
+	if langName := filenameLanguages[base]; configured(langName) {
+		return langName, true
+	}
+	if strings.HasPrefix(base, "Dockerfile.") && configured("dockerfile") {
+		return "dockerfile", true
+	}
+
+	// 扩展名优先于模式行，扫描器不读取内容也能得到与解析器相同的语言；
+	// .h 同时用于 C、C++ 和 Objective-C，由模式行或内容判断
+	if ext := filepath.Ext(filePath); ext != "" {
+		if langName, _, found := GetLanguageByExtension(languages, ext); found {
+			if ext == ".h" {
+				if modeLang, found := GetLanguageByModeline(string(content)); found && configured(modeLang) {
+					return modeLang, true
+				}
+				if headerLang := detectHeaderLanguage(string(content)); configured(headerLang) {
+					return headerLang, true
+				}
+			}
+			return langName, true
+		}
+	}
+
+	// 编辑器模式行
+	if langName, found := GetLanguageByModeline(string(content)); found && configured(langName) {
+		return langName, true
+	}
+
+	// shebang
+	firstLine, _, _ := strings.Cut(string(content), "\n")
+	if langName, found := GetLanguageByShebang(firstLine); found && configured(langName) {
+		return langName, true
+	}
+
+	return "", false
+}
+
+// GetLanguageByModeline 根据文件开头或结尾的 vim/emacs 模式行获取语言名称
+func GetLanguageByModeline(content string) (string, bool) {
+	lines := strings.Split(content, "\n")
+	candidates := lines
+	if len(lines) > modelineScanLines*2 {
+		candidates = append(append([]string{}, lines[:modelineScanLines]...), lines[len(lines)-modelineScanLines:]...)
+	}
+
+	for _, line := range candidates {
+		mode := ""
+		if match := vimModelineRegex.FindStringSubmatch(line); match != nil {
+			mode = match[1]
+		} else if match := emacsModelineRegex.FindStringSubmatch(line); match != nil {
+			mode = match[1]
+			if modeMatch := emacsModeRegex.FindStringSubmatch(mode); modeMatch != nil {
+				mode = modeMatch[1]
+			} else if strings.ContainsAny(mode, ":;") {
+				// 只有 coding 等其他变量
+				continue
+			}
+		}
+
+		if langName, exists := modeAliases[strings.ToLower(mode)]; exists {
+			return langName, true
+		}
+	}
+	return "", false
+}
+
+// IsObjectiveCHeader 根据内容判断 .h 头文件是否为 Objective-C
+func IsObjectiveCHeader(content string) bool {
+	return objectiveCHeaderRegex.MatchString(content)
+}
+
+// detectHeaderLanguage 根据内容判断 .h 头文件是 Objective-C 还是 C++，无法判断时返回空字符串
+func detectHeaderLanguage(content string) string {
+	switch {
+	case IsObjectiveCHeader(content):
+		return "objc"
+	case cppHeaderRegex.MatchString(content):
+		return "cpp"
+	default:
+		return ""
+	}
+}
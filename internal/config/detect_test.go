@@ -0,0 +1,102 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	languages := GetDefaultLanguagesConfig()
+
+	testCases := []struct {
+		name     string
+		path     string
+		content  string
+		expected string
+		found    bool
+	}{
+		{"extension", "src/main.go", "package main\n", "go", true},
+		{"c header", "include/util.h", "#include <stdio.h>\n\nint add(int a, int b);\n", "c", true},
+		{"cpp header", "include/widget.h", "#pragma once\n#include <vector>\n\nclass Widget {\npublic:\n  int size() const;\n};\n", "cpp", true},
+		{"objc header", "Classes/User.h", "#import <Foundation/Foundation.h>\n\n@interface User : NSObject\n@end\n", "objc", true},
+		{"emacs modeline in header", "include/traits.h", "// -*- C++ -*-\nint x;\n", "cpp", true},
+		{"vim modeline", "tools/gen", "print('hi')\n# vim: set ft=python:\n", "python", true},
+		{"extension wins over modeline", "tools/gen.py", "# vim: set ft=sh:\nprint('hi')\n", "python", true},
+		{"modeline for unknown extension", "tools/gen.in", "# vim: set ft=python:\nprint('hi')\n", "python", true},
+		{"emacs mode variable", "tools/run", "# -*- mode: sh; coding: utf-8 -*-\necho hi\n", "shell", true},
+		{"shebang", "bin/deploy", "#!/usr/bin/env bash\necho deploy\n", "shell", true},
+		{"python shebang", "bin/manage", "#!/usr/bin/env python3\nprint('hi')\n", "python", true},
+		{"bazel build file", "pkg/BUILD", "go_library(name = \"pkg\")\n", "python", true},
+		{"dockerfile", "Dockerfile", "FROM alpine\n", "dockerfile", true},
+		{"dockerfile variant", "deploy/Dockerfile.prod", "FROM alpine\n", "dockerfile", true},
+		{"makefile", "Makefile", "all: build\n", "make", true},
+		{"cmake lists", "src/CMakeLists.txt", "project(app)\n", "cmake", true},
+		{"unknown extensionless", "LICENSE", "MIT License\n", "", false},
+		{"jenkinsfile without groovy support", "Jenkinsfile", "pipeline {\n  agent any\n}\n", "", false},
+		{"groovy modeline without groovy support", "ci/build", "// vim: set ft=groovy:\npipeline {}\n", "", false},
+		{"unknown extension", "notes.txt", "hello\n", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			langName, found := DetectLanguage(languages, tc.path, []byte(tc.content))
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, langName)
+		})
+	}
+}
+
+func TestDetectLanguageOnlyConfigured(t *testing.T) {
+	languages := GetDefaultLanguagesConfig()
+	delete(languages, "cpp")
+
+	// 未配置 C++ 时 .h 仍按扩展名处理
+	langName, found := DetectLanguage(languages, "widget.h", []byte("namespace app {\nclass Widget {};\n}\n"))
+	assert.True(t, found)
+	assert.Equal(t, "c", langName)
+}
+
+func TestGetLanguageByModeline(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+		found    bool
+	}{
+		{"vim ft", "/* vim: set ft=cpp : */\n", "cpp", true},
+		{"vim filetype", "// vi: filetype=javascript\n", "javascript", true},
+		{"emacs short form", "/* -*- C++ -*- */\n", "cpp", true},
+		{"emacs mode", ";; -*- mode: python -*-\n", "python", true},
+		{"emacs coding only", "# -*- coding: utf-8 -*-\n", "", false},
+		{"no modeline", "int main(void) { return 0; }\n", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			langName, found := GetLanguageByModeline(tc.content)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, langName)
+		})
+	}
+}
+
+func TestIsObjectiveCHeader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"interface", "#import <Foundation/Foundation.h>\n\n@interface Foo : NSObject\n@end\n", true},
+		{"protocol", "@protocol Delegate <NSObject>\n@end\n", true},
+		{"nonnull macro", "NS_ASSUME_NONNULL_BEGIN\nvoid foo(void);\nNS_ASSUME_NONNULL_END\n", true},
+		{"c header", "#include <stdio.h>\n\nint add(int a, int b);\n", false},
+		{"cpp header", "#pragma once\nclass Foo {\npublic:\n  int x;\n};\n", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsObjectiveCHeader(tc.content))
+		})
+	}
+}
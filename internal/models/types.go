@@ -2,21 +2,78 @@ package models
 
 import "time"
 
+// 符号可见性（Symbol.Visibility 的取值）
+const (
+	VisibilityPublic    = "public"    // 对外公开
+	VisibilityProtected = "protected" // 仅子类可见
+	VisibilityPrivate   = "private"   // 仅类型或文件内部可见
+	VisibilityInternal  = "internal"  // 仅程序集或 crate 内可见
+	VisibilityPackage   = "package"   // 仅包内可见（Java 默认、Go 小写名称）
+	VisibilityExported  = "exported"  // 由模块导出（Go 大写名称、JS export、Erlang -export 等）
+)
+
 // Symbol 表示代码中的一个符号（如函数、结构体、常量等）
 type Symbol struct {
-	Prototype string   `json:"prototype"`         // 符号的完整声明行
-	Purpose   string   `json:"purpose"`           // 从注释中提取的说明
-	Range     []int    `json:"range"`             // [start_line, end_line]
-	Body      string   `json:"body,omitempty"`    // 用于类/结构体/接口等容器类型的内部内容
-	Methods   []Symbol `json:"methods,omitempty"` // 用于类/结构体的方法
+	Prototype     string      `json:"prototype"`               // 符号的完整声明行
+	Purpose       string      `json:"purpose"`                 // 从注释中提取的说明
+	Range         []int       `json:"range"`                   // [start_line, end_line]
+	Kind          string      `json:"kind,omitempty"`          // 符号类别标记（如 widget、test），为空表示普通符号
+	Export        string      `json:"export,omitempty"`        // 模块导出方式（default、named），为空表示未导出
+	Visibility    string      `json:"visibility,omitempty"`    // 可见性（public、protected、private、internal、package、exported），为空表示语言没有可见性概念
+	Modifiers     []string    `json:"modifiers,omitempty"`     // 声明上的修饰符（如 static、abstract、async、const、virtual、override）
+	TypeParams    []TypeParam `json:"typeParams,omitempty"`    // 泛型或模板的类型参数
+	Params        []Param     `json:"params,omitempty"`        // 函数、方法或构造函数的参数列表
+	Returns       []string    `json:"returns,omitempty"`       // 返回值类型列表（Go 等支持多返回值的语言按顺序列出）
+	Doc           *DocComment `json:"doc,omitempty"`           // 结构化的文档注释（参数和返回值说明）
+	Props         string      `json:"props,omitempty"`         // React 组件的 props 类型或解构的属性
+	Hooks         []string    `json:"hooks,omitempty"`         // React 组件或 hook 中调用的 hooks
+	Implements    []string    `json:"implements,omitempty"`    // 类型实现的 trait 或接口
+	Annotations   []string    `json:"annotations,omitempty"`   // 成员上的注解（如 @Override、@GetMapping("/x")）
+	QualifiedName string      `json:"qualifiedName,omitempty"` // 带命名空间和类名的限定名称（如 ui::Widget::size、Erlang 的 demo:add/2），用于跨文件关联声明与实现
+	Declaration   string      `json:"declaration,omitempty"`   // 实现对应的声明位置（文件:行号）
+	Definition    string      `json:"definition,omitempty"`    // 声明对应的实现位置（文件:行号）
+	Partial       []string    `json:"partial,omitempty"`       // 分部类型（C# partial）其他部分的位置（文件:行号）
+	MergedFrom    string      `json:"mergedFrom,omitempty"`    // 从其他文件合并的分部类型成员的实际位置（文件:行号），为空表示成员位于本文件
+	Body          string      `json:"body,omitempty"`          // 用于类/结构体/接口等容器类型的内部内容
+	Methods       []Symbol    `json:"methods,omitempty"`       // 用于类/结构体的方法
+	Children      []Symbol    `json:"children,omitempty"`      // 用于表的列、结构体字段、枚举成员等非方法的子成员
+}
+
+// DocComment 表示从 JSDoc 等文档注释中解析出的结构化说明，摘要保存在 Symbol.Purpose 中
+type DocComment struct {
+	Params  []DocParam `json:"params,omitempty"`  // 参数说明
+	Returns *DocParam  `json:"returns,omitempty"` // 返回值说明（Name 为空）
+}
+
+// DocParam 表示文档注释中的一个参数或返回值
+type DocParam struct {
+	Name        string `json:"name,omitempty"`        // 参数名称
+	Type        string `json:"type,omitempty"`        // 声明的类型
+	Description string `json:"description,omitempty"` // 说明
+}
+
+// Param 表示函数声明中的一个参数
+type Param struct {
+	Name     string `json:"name,omitempty"`     // 参数名称（匿名参数为空）
+	Type     string `json:"type,omitempty"`     // 声明的类型，动态类型语言未标注时为空
+	Default  string `json:"default,omitempty"`  // 默认值表达式
+	Variadic bool   `json:"variadic,omitempty"` // 是否为可变参数（...args、*args、params 等）
+}
+
+// TypeParam 表示泛型或模板的一个类型参数
+type TypeParam struct {
+	Name       string `json:"name"`                 // 类型参数名称
+	Constraint string `json:"constraint,omitempty"` // 约束或上界（如 comparable、extends Foo、: Clone）
+	Default    string `json:"default,omitempty"`    // 默认类型
 }
 
 // FileInfo 表示一个文件的信息
 type FileInfo struct {
-	Purpose      string   `json:"purpose"`      // 文件的用途描述
-	Symbols      []Symbol `json:"symbols"`      // 文件中的符号列表
-	LastModified string   `json:"lastModified"` // 文件最后修改时间
-	FileSize     int64    `json:"fileSize"`     // 文件大小
+	Purpose      string   `json:"purpose"`            // 文件的用途描述
+	Language     string   `json:"language,omitempty"` // 检测到的语言名称（如 go、cpp、objc）
+	Symbols      []Symbol `json:"symbols"`            // 文件中的符号列表
+	LastModified string   `json:"lastModified"`       // 文件最后修改时间
+	FileSize     int64    `json:"fileSize"`           // 文件大小
 }
 
 // ProjectContext 表示整个项目的上下文信息
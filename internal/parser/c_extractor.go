@@ -7,6 +7,9 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+// maxMacroPrototypeLength 宏定义原型的最大字符数，更长的宏体被截断
+const maxMacroPrototypeLength = 120
+
 // CExtractor C语言提取器
 type CExtractor struct {
 	BaseExtractor
@@ -30,19 +33,36 @@ func (c *CExtractor) GetQueries() []string {
 
 // ExtractPrototype 提取C函数原型
 func (c *CExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
-	nodeType := node.Type()
-
-	// 对于函数定义，提取函数签名（不包含函数体）
-	if nodeType == "function_definition" {
+	switch node.Type() {
+	case "function_definition":
+		// 对于函数定义，提取函数签名（不包含函数体）
 		return c.extractFunctionPrototype(node, content, c.IsFunctionBodyNode)
-	}
 
-	// 对于类型定义，返回完整内容（typedef）
-	if nodeType == "type_definition" {
+	case "type_definition":
+		// typedef struct { ... } Name 的类型体省略为 { ... }，避免匿名结构体看起来像带标签的 struct Name
+		if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+			if body := typeNode.ChildByFieldName("body"); body != nil {
+				head := string(content[node.StartByte():body.StartByte()])
+				tail := strings.TrimSuffix(strings.TrimSpace(string(content[body.EndByte():node.EndByte()])), ";")
+				return c.cleanText(head + " { ... } " + tail)
+			}
+		}
+
+	case "struct_specifier", "union_specifier", "enum_specifier":
+		if body := node.ChildByFieldName("body"); body != nil {
+			return c.cleanText(string(content[node.StartByte():body.StartByte()]))
+		}
+
+	case "preproc_def", "preproc_function_def":
+		// 宏定义去掉续行符，过长的宏体被截断
+		text := c.cleanText(strings.ReplaceAll(string(content[node.StartByte():node.EndByte()]), "\\\n", " "))
+		return truncateRunes(text, maxMacroPrototypeLength)
+
+	case "enumerator":
 		return c.extractFullNode(node, content)
 	}
 
-	return c.extractFullNode(node, content)
+	return strings.TrimSuffix(c.extractFullNode(node, content), ";")
 }
 
 // ExtractMethods 提取C方法（C语言没有类，所以返回空）
@@ -76,6 +96,268 @@ func (c *CExtractor) ExtractComments(node *sitter.Node, content []byte) string {
 	return c.extractCComments(node, content)
 }
 
+// ExtractSymbols 提取C声明：函数定义和原型、宏（包括带参数的宏）、extern 全局变量、
+// 结构体/联合体/枚举（成员和枚举常量作为子符号）和 typedef，递归进入条件编译块和 extern "C" 块。
+// 函数原型标记为 declaration，非 static 函数记录函数名作为限定名称，用于与实现配对
+func (c *CExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	return c.extractDeclarations(root, content)
+}
+
+// extractDeclarations 提取翻译单元、条件编译块或 extern "C" 块中的声明
+func (c *CExtractor) extractDeclarations(list *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		child := list.NamedChild(i)
+
+		switch child.Type() {
+		case "preproc_if", "preproc_ifdef", "preproc_else", "preproc_elif", "preproc_elifdef":
+			symbols = append(symbols, c.extractDeclarations(child, content)...)
+
+		case "linkage_specification":
+			if body := child.ChildByFieldName("body"); body != nil {
+				if body.Type() == "declaration_list" {
+					symbols = append(symbols, c.extractDeclarations(body, content)...)
+				} else if symbol, ok := c.createDeclarationSymbol(body, content); ok {
+					symbols = append(symbols, symbol)
+				}
+			}
+
+		default:
+			if symbol, ok := c.createDeclarationSymbol(child, content); ok {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+
+	return symbols
+}
+
+// createDeclarationSymbol 为单个顶层声明创建符号，局部变量、include 等不需要输出的声明返回 false
+func (c *CExtractor) createDeclarationSymbol(node *sitter.Node, content []byte) (models.Symbol, bool) {
+	switch node.Type() {
+	case "function_definition":
+		symbol := c.createLinkageSymbol(node, content)
+		if !c.isStatic(node, content) {
+			symbol.QualifiedName = c.functionName(node, content)
+		}
+		return symbol, true
+
+	case "declaration":
+		if typeNode := node.ChildByFieldName("type"); typeNode != nil && typeNode.ChildByFieldName("body") != nil {
+			// struct point { ... } origin; 这类声明中的类型定义
+			return c.createTypeSymbol(typeNode, node, content), true
+		}
+		if c.isFunctionPointer(node) {
+			// void (*callback)(int); 是函数指针变量，不是函数原型
+			return c.createLinkageSymbol(node, content), true
+		}
+		if c.functionDeclarator(node) != nil {
+			symbol := c.createLinkageSymbol(node, content)
+			symbol.Kind = cppKindDeclaration
+			if !c.isStatic(node, content) {
+				symbol.QualifiedName = c.functionName(node, content)
+			}
+			return symbol, true
+		}
+		if c.hasStorageClass(node, "extern", content) {
+			return c.createLinkageSymbol(node, content), true
+		}
+
+	case "preproc_def", "preproc_function_def":
+		if c.isIncludeGuard(node, content) {
+			return models.Symbol{}, false
+		}
+		symbol := c.createSymbol(node, content)
+		symbol.Params = cParams(node.ChildByFieldName("parameters"), content)
+		return symbol, true
+
+	case "struct_specifier", "union_specifier", "enum_specifier":
+		if node.ChildByFieldName("body") != nil {
+			return c.createTypeSymbol(node, node, content), true
+		}
+
+	case "type_definition":
+		symbol := c.createSymbol(node, content)
+		if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+			symbol.Children = c.extractTypeMembers(typeNode, content)
+		}
+		return symbol, true
+	}
+
+	return models.Symbol{}, false
+}
+
+// createTypeSymbol 创建结构体、联合体或枚举符号，rangeNode 为包含该类型的声明
+func (c *CExtractor) createTypeSymbol(typeNode, rangeNode *sitter.Node, content []byte) models.Symbol {
+	symbol := c.createSymbol(rangeNode, content)
+	symbol.Prototype = c.ExtractPrototype(typeNode, content)
+	symbol.Children = c.extractTypeMembers(typeNode, content)
+	return symbol
+}
+
+// extractTypeMembers 提取结构体/联合体的字段或枚举常量
+func (c *CExtractor) extractTypeMembers(typeNode *sitter.Node, content []byte) []models.Symbol {
+	body := typeNode.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+
+	var members []models.Symbol
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		if member.Type() != "enumerator" && member.Type() != "field_declaration" {
+			continue
+		}
+		symbol := c.createSymbol(member, content)
+		// 成员只使用类型体内紧挨着的注释，避免单行的 typedef struct { int a; } 取到整个声明上方的注释
+		if prev := member.PrevNamedSibling(); prev == nil || prev.Type() != "comment" {
+			symbol.Purpose = ""
+		}
+		// 没有上方注释时使用同一行末尾的注释，如 RED, /* 红色 */
+		if next := member.NextNamedSibling(); symbol.Purpose == "" && next != nil && next.Type() == "comment" &&
+			next.StartPoint().Row == member.EndPoint().Row {
+			symbol.Purpose = strings.TrimSpace(strings.TrimSuffix(strings.TrimLeft(next.Content(content), "/*"), "*/"))
+		}
+		members = append(members, symbol)
+	}
+	return members
+}
+
+// createSymbol 创建基础符号
+func (c *CExtractor) createSymbol(node *sitter.Node, content []byte) models.Symbol {
+	start := node.StartPoint()
+	end := node.EndPoint()
+
+	// 预处理指令的节点包含行尾换行符
+	endRow := int(end.Row)
+	if end.Column == 0 && endRow > int(start.Row) {
+		endRow--
+	}
+
+	return models.Symbol{
+		Prototype: c.ExtractPrototype(node, content),
+		Purpose:   c.extractCComments(node, content),
+		Range:     []int{int(start.Row) + 1, endRow + 1},
+	}
+}
+
+// createLinkageSymbol 为函数和全局变量创建符号，记录存储类说明符：static 的只在本文件内可见，其他为 public
+func (c *CExtractor) createLinkageSymbol(node *sitter.Node, content []byte) models.Symbol {
+	symbol := c.createSymbol(node, content)
+	symbol.Visibility = visibilityPublic
+	if c.isStatic(node, content) {
+		symbol.Visibility = visibilityPrivate
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "storage_class_specifier" {
+			symbol.Modifiers = appendModifier(symbol.Modifiers, child.Content(content))
+		}
+	}
+	if declarator := c.functionDeclarator(node); declarator != nil {
+		symbol.Params = cParams(declarator.ChildByFieldName("parameters"), content)
+		symbol.Returns = cReturns(node, declarator, content)
+	}
+	return symbol
+}
+
+// functionDeclarator 沿着指针声明符查找函数声明符，不是函数（包括函数指针）时返回 nil
+func (c *CExtractor) functionDeclarator(node *sitter.Node) *sitter.Node {
+	declarator := node.ChildByFieldName("declarator")
+	for declarator != nil {
+		switch declarator.Type() {
+		case "function_declarator":
+			if c.isPointerVariable(declarator.ChildByFieldName("declarator")) {
+				return nil
+			}
+			return declarator
+		case "pointer_declarator":
+			declarator = declarator.ChildByFieldName("declarator")
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// isFunctionPointer 检查声明的变量是否为函数指针，如 void (*callback)(int)、int (*table[4])(void)
+func (c *CExtractor) isFunctionPointer(node *sitter.Node) bool {
+	declarator := node.ChildByFieldName("declarator")
+	for declarator != nil {
+		switch declarator.Type() {
+		case "pointer_declarator":
+			declarator = declarator.ChildByFieldName("declarator")
+		case "function_declarator":
+			return c.isPointerVariable(declarator.ChildByFieldName("declarator"))
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// isPointerVariable 检查函数声明符中括号内的部分是否为指针变量 (*name)；
+// 括号内还有函数声明符时（如 void (*signal(int))(int)）声明的是返回函数指针的函数
+func (c *CExtractor) isPointerVariable(declarator *sitter.Node) bool {
+	if declarator == nil || declarator.Type() != "parenthesized_declarator" {
+		return false
+	}
+	for declarator != nil {
+		switch declarator.Type() {
+		case "function_declarator":
+			return false
+		case "identifier":
+			return true
+		}
+		if next := declarator.ChildByFieldName("declarator"); next != nil {
+			declarator = next
+		} else {
+			declarator = declarator.NamedChild(0)
+		}
+	}
+	return false
+}
+
+// functionName 返回函数名
+func (c *CExtractor) functionName(node *sitter.Node, content []byte) string {
+	if declarator := c.functionDeclarator(node); declarator != nil {
+		if name := declarator.ChildByFieldName("declarator"); name != nil && name.Type() == "identifier" {
+			return name.Content(content)
+		}
+	}
+	return ""
+}
+
+// isStatic 检查声明是否为 static（仅在本文件内可见）
+func (c *CExtractor) isStatic(node *sitter.Node, content []byte) bool {
+	return c.hasStorageClass(node, "static", content)
+}
+
+// hasStorageClass 检查声明是否带有指定的存储类说明符（extern、static 等）
+func (c *CExtractor) hasStorageClass(node *sitter.Node, storageClass string, content []byte) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "storage_class_specifier" && child.Content(content) == storageClass {
+			return true
+		}
+	}
+	return false
+}
+
+// isIncludeGuard 检查没有值的 #define 是否为头文件的 include guard（#ifndef FOO_H 后紧跟 #define FOO_H）
+func (c *CExtractor) isIncludeGuard(node *sitter.Node, content []byte) bool {
+	if node.Type() != "preproc_def" || node.ChildByFieldName("value") != nil {
+		return false
+	}
+	parent := node.Parent()
+	if parent == nil || parent.Type() != "preproc_ifdef" {
+		return false
+	}
+	guard := parent.ChildByFieldName("name")
+	name := node.ChildByFieldName("name")
+	return guard != nil && name != nil && guard.Content(content) == name.Content(content)
+}
+
 // extractCComments 提取C注释
 func (c *CExtractor) extractCComments(node *sitter.Node, content []byte) string {
 	startPoint := node.StartPoint()
@@ -97,6 +379,18 @@ func (c *CExtractor) extractCComments(node *sitter.Node, content []byte) string
 
 		// 检查多行注释结束
 		if strings.HasSuffix(line, "*/") {
+			// 单行的 /* ... */ 注释
+			if strings.HasPrefix(line, "/*") {
+				comment := strings.TrimSpace(strings.TrimLeft(strings.TrimSuffix(line, "*/"), "/*"))
+				if comment != "" {
+					commentLines = append([]string{comment}, commentLines...)
+				}
+				break
+			}
+			// 代码行末尾的注释属于该行代码
+			if strings.Contains(line, "/*") {
+				break
+			}
 			inMultiLineComment = true
 			comment := strings.TrimSpace(strings.TrimSuffix(line, "*/"))
 			if comment != "" {
@@ -115,7 +409,7 @@ func (c *CExtractor) extractCComments(node *sitter.Node, content []byte) string
 				continue
 			} else if strings.HasPrefix(line, "/*") {
 				// 多行注释开始
-				comment := strings.TrimSpace(strings.TrimPrefix(line, "/*"))
+				comment := strings.TrimSpace(strings.TrimLeft(line, "/*"))
 				if comment != "" {
 					commentLines = append([]string{comment}, commentLines...)
 				}
@@ -126,7 +420,7 @@ func (c *CExtractor) extractCComments(node *sitter.Node, content []byte) string
 
 		// 检查单行注释
 		if strings.HasPrefix(line, "//") {
-			comment := strings.TrimSpace(strings.TrimPrefix(line, "//"))
+			comment := strings.TrimSpace(strings.TrimLeft(line, "/"))
 			if comment != "" {
 				return comment
 			}
@@ -145,3 +439,100 @@ func (c *CExtractor) extractCComments(node *sitter.Node, content []byte) string
 
 	return ""
 }
+
+// cParams 解析 C/C++ 的参数列表：类型为去掉参数名和默认值后的声明文本（如 const char *、int (*)(int)），
+// 单独的 void 表示没有参数，... 和 C++ 的参数包标记为可变参数
+func cParams(list *sitter.Node, content []byte) []models.Param {
+	if list == nil {
+		return nil
+	}
+
+	var params []models.Param
+	for i := 0; i < int(list.ChildCount()); i++ {
+		node := list.Child(i)
+		switch node.Type() {
+		case "...", "variadic_parameter":
+			params = append(params, models.Param{Variadic: true})
+		case "parameter_declaration", "optional_parameter_declaration", "variadic_parameter_declaration":
+			if text := nodeText(node, content); text == "void" {
+				continue
+			}
+			params = append(params, cParam(node, content))
+		case "identifier":
+			// 宏参数
+			params = append(params, models.Param{Name: node.Content(content)})
+		}
+	}
+	return params
+}
+
+// cParam 解析单个参数声明
+func cParam(node *sitter.Node, content []byte) models.Param {
+	param := models.Param{
+		Default:  fieldText(node, "default_value", content),
+		Variadic: node.Type() == "variadic_parameter_declaration",
+	}
+
+	end := node.EndByte()
+	if defaultValue := node.ChildByFieldName("default_value"); defaultValue != nil {
+		// 去掉 = 默认值
+		end = defaultValue.StartByte()
+		for end > node.StartByte() && content[end-1] != '=' {
+			end--
+		}
+		end--
+	}
+	text := string(content[node.StartByte():end])
+	if name := cDeclaratorName(node.ChildByFieldName("declarator")); name != nil {
+		param.Name = name.Content(content)
+		offset := name.StartByte() - node.StartByte()
+		text = text[:offset] + text[offset+name.EndByte()-name.StartByte():]
+	}
+	param.Type = compactText(strings.Replace(text, "...", "", 1))
+	return param
+}
+
+// cDeclaratorName 沿声明符向内查找声明的名称，抽象声明符（没有名称）返回 nil
+func cDeclaratorName(declarator *sitter.Node) *sitter.Node {
+	for declarator != nil {
+		switch declarator.Type() {
+		case "identifier", "field_identifier":
+			return declarator
+		}
+		next := declarator.ChildByFieldName("declarator")
+		if next == nil && declarator.NamedChildCount() > 0 {
+			// 引用、括号和参数包声明符的内层声明符没有字段名
+			next = declarator.NamedChild(0)
+		}
+		declarator = next
+	}
+	return nil
+}
+
+// cReturns 提取函数声明的返回类型：从类型说明符开始到声明符中函数声明符之前的文本（保留 * 和 &），
+// 构造函数、析构函数和 void 函数没有返回值，auto f() -> T 使用尾置返回类型
+func cReturns(node, declarator *sitter.Node, content []byte) []string {
+	typeNode := node.ChildByFieldName("type")
+	if typeNode == nil || declarator == nil {
+		return nil
+	}
+	for i := 0; i < int(declarator.NamedChildCount()); i++ {
+		if trailing := declarator.NamedChild(i); trailing.Type() == "trailing_return_type" {
+			return []string{strings.TrimSpace(strings.TrimPrefix(nodeText(trailing, content), "->"))}
+		}
+	}
+
+	start := typeNode.StartByte()
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "type_qualifier" && !cppDeclarationQualifiers[child.Content(content)] && child.StartByte() < start {
+			start = child.StartByte()
+		}
+	}
+	outer := node.ChildByFieldName("declarator")
+	returnType := string(content[start:declarator.StartByte()]) + string(content[declarator.EndByte():outer.EndByte()])
+	if returnType = compactText(returnType); returnType == "void" {
+		return nil
+	}
+	return []string{returnType}
+}
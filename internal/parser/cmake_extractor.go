@@ -0,0 +1,231 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// cmakeSyntax CMake 的注释和字符串语法
+	cmakeSyntax = textSyntax{
+		lineComments: []string{"#"},
+		quotes:       `"`,
+	}
+
+	// cmakeCommandRegex 匹配行首的命令调用
+	cmakeCommandRegex = regexp.MustCompile(`(?m)^[ \t]*([A-Za-z_]\w*)[ \t]*\(`)
+
+	// cmakeDescriptionRegex 匹配 project() 的 DESCRIPTION 参数
+	cmakeDescriptionRegex = regexp.MustCompile(`\bDESCRIPTION\s+"([^"]*)"`)
+)
+
+// cmakeTargetCommands 定义构建目标的命令
+var cmakeTargetCommands = map[string]bool{
+	"add_executable":    true,
+	"add_library":       true,
+	"add_custom_target": true,
+}
+
+// cmakeTargetKeywords 目标名称之后保留在原型中的关键字
+var cmakeTargetKeywords = map[string]bool{
+	"STATIC":           true,
+	"SHARED":           true,
+	"MODULE":           true,
+	"OBJECT":           true,
+	"INTERFACE":        true,
+	"IMPORTED":         true,
+	"GLOBAL":           true,
+	"ALIAS":            true,
+	"ALL":              true,
+	"WIN32":            true,
+	"MACOSX_BUNDLE":    true,
+	"EXCLUDE_FROM_ALL": true,
+}
+
+// cmakeBlockEnds 函数和宏定义的结束命令
+var cmakeBlockEnds = map[string]string{
+	"function": "endfunction",
+	"macro":    "endmacro",
+}
+
+// CMakeExtractor CMakeLists.txt 和 .cmake 提取器（基于文本，没有可用的 Tree-sitter 语法）
+type CMakeExtractor struct {
+	BaseExtractor
+}
+
+// NewCMakeExtractor 创建CMake提取器
+func NewCMakeExtractor() *CMakeExtractor {
+	return &CMakeExtractor{}
+}
+
+// cmakeCommand 一次命令调用
+type cmakeCommand struct {
+	name      string   // 命令名称（小写）
+	args      []string // 参数（去除引号）
+	start     int      // 命令开始位置
+	end       int      // 右括号之后的位置
+	startLine int
+	endLine   int
+}
+
+// ExtractSymbols 提取 project、构建目标（target_* 命令作为子符号）、option、add_subdirectory 以及函数和宏定义
+func (c *CMakeExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	text := string(content)
+	masked := maskSource(text, cmakeSyntax)
+	lines := strings.Split(text, "\n")
+	commands := c.parseCommands(text, masked)
+
+	var symbols []models.Symbol
+	targets := make(map[string]int)
+	blockEnd := ""
+
+	for _, command := range commands {
+		// 跳过函数和宏定义内部的命令
+		if blockEnd != "" {
+			if command.name == blockEnd {
+				blockEnd = ""
+			}
+			continue
+		}
+
+		symbol := models.Symbol{
+			Prototype: c.commandText(text, command),
+			Purpose:   docCommentAbove(lines, command.startLine, "#"),
+			Range:     []int{command.startLine, command.endLine},
+		}
+
+		switch {
+		case command.name == "project", command.name == "add_subdirectory":
+
+		case cmakeTargetCommands[command.name] && len(command.args) > 0:
+			symbol.Prototype = c.targetPrototype(command)
+			targets[command.args[0]] = len(symbols)
+
+		case command.name == "option" || command.name == "cmake_dependent_option":
+			if len(command.args) > 1 && symbol.Purpose == "" {
+				symbol.Purpose = command.args[1]
+			}
+
+		case cmakeBlockEnds[command.name] != "":
+			blockEnd = cmakeBlockEnds[command.name]
+			if len(command.args) > 1 {
+				// 名称之后的参数为形参，其余实参通过 ARGN 访问
+				for _, arg := range command.args[1:] {
+					symbol.Params = append(symbol.Params, models.Param{Name: arg})
+				}
+			}
+			for _, end := range commands {
+				if end.name == blockEnd && end.start > command.start {
+					symbol.Range[1] = end.endLine
+					break
+				}
+			}
+
+		case strings.HasPrefix(command.name, "target_") && len(command.args) > 0:
+			if index, ok := targets[command.args[0]]; ok {
+				symbols[index].Children = append(symbols[index].Children, symbol)
+			}
+			continue
+
+		default:
+			continue
+		}
+
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的 # 注释块，否则使用 project() 的 DESCRIPTION
+func (c *CMakeExtractor) ExtractFilePurpose(content []byte) string {
+	if purpose := leadingHashComment(strings.Split(string(content), "\n")); purpose != "" {
+		return purpose
+	}
+	if match := cmakeDescriptionRegex.FindStringSubmatch(string(content)); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// parseCommands 按顺序解析所有命令调用
+func (c *CMakeExtractor) parseCommands(text, masked string) []cmakeCommand {
+	var commands []cmakeCommand
+	for _, match := range cmakeCommandRegex.FindAllStringSubmatchIndex(masked, -1) {
+		open := match[1] - 1
+		closeIdx := matchBrace(masked, open)
+		if closeIdx < 0 {
+			continue
+		}
+
+		start := skipSpace(masked, match[0])
+		commands = append(commands, cmakeCommand{
+			name:      strings.ToLower(masked[match[2]:match[3]]),
+			args:      c.splitArguments(text, masked, open+1, closeIdx),
+			start:     start,
+			end:       closeIdx + 1,
+			startLine: lineOfOffset(masked, start),
+			endLine:   lineOfOffset(masked, closeIdx),
+		})
+	}
+	return commands
+}
+
+// splitArguments 按空白拆分参数，带引号的参数作为一个整体并去除引号
+func (c *CMakeExtractor) splitArguments(text, masked string, start, end int) []string {
+	var args []string
+	for i := start; i < end; {
+		i = skipSpace(masked, i)
+		if i >= end {
+			break
+		}
+
+		argStart := i
+		if masked[i] == '"' {
+			closeIdx := strings.IndexByte(masked[i+1:end], '"')
+			if closeIdx < 0 {
+				closeIdx = end - i - 1
+			}
+			args = append(args, text[i+1:i+1+closeIdx])
+			i += closeIdx + 2
+			continue
+		}
+		for i < end && strings.IndexByte(" \t\r\n", masked[i]) < 0 {
+			if masked[i] == '(' {
+				if closeIdx := matchBrace(masked, i); closeIdx > 0 && closeIdx < end {
+					i = closeIdx
+				}
+			}
+			i++
+		}
+		if arg := strings.TrimSpace(masked[argStart:i]); arg != "" {
+			args = append(args, text[argStart:i])
+		}
+	}
+	return args
+}
+
+// targetPrototype 生成目标原型：目标名称和类型关键字，不包含源文件列表
+func (c *CMakeExtractor) targetPrototype(command cmakeCommand) string {
+	parts := []string{command.args[0]}
+	for i := 1; i < len(command.args); i++ {
+		arg := command.args[i]
+		if !cmakeTargetKeywords[arg] {
+			break
+		}
+		parts = append(parts, arg)
+		if arg == "ALIAS" && i+1 < len(command.args) {
+			parts = append(parts, command.args[i+1])
+			break
+		}
+	}
+	return command.name + "(" + strings.Join(parts, " ") + ")"
+}
+
+// commandText 返回去除注释、合并空白的命令文本，过长时截断
+func (c *CMakeExtractor) commandText(text string, command cmakeCommand) string {
+	masked := maskSource(text[command.start:command.end], textSyntax{lineComments: cmakeSyntax.lineComments})
+	return truncateConfigValue(c.cleanText(masked))
+}
@@ -0,0 +1,738 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+const (
+	// maxConfigFileSize 超过该大小的配置/数据文件不生成大纲，避免大型数据文件撑大结果
+	maxConfigFileSize = 256 * 1024
+
+	// maxConfigSymbols 单个配置文件最多输出的符号数量（包含子符号）
+	maxConfigSymbols = 200
+
+	// maxConfigValueLength 原型中标量值保留的最大长度
+	maxConfigValueLength = 80
+)
+
+// configHTTPMethods OpenAPI 路径下表示接口操作的键
+var configHTTPMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// configDependencyKeys package.json 中的依赖分组
+var configDependencyKeys = []string{"dependencies", "devDependencies", "peerDependencies", "optionalDependencies"}
+
+// configSchema 可识别的配置文件格式
+type configSchema int
+
+const (
+	configSchemaGeneric configSchema = iota
+	configSchemaGitHubWorkflow
+	configSchemaCompose
+	configSchemaOpenAPI
+	configSchemaPackageJSON
+)
+
+// configNode 配置文件中的一个键（或数组元素）及其取值
+type configNode struct {
+	key       string
+	value     string        // 标量值，映射和数组为空
+	header    string        // TOML 表头（如 [package]），其他格式为空
+	startLine int           // 开始行（从1开始）
+	endLine   int           // 结束行（从1开始）
+	children  []*configNode // 映射的成员或数组的元素
+}
+
+// child 按键名查找直接子节点
+func (n *configNode) child(key string) *configNode {
+	if n == nil {
+		return nil
+	}
+	for _, child := range n.children {
+		if child.key == key {
+			return child
+		}
+	}
+	return nil
+}
+
+// lookup 按路径查找标量值，不存在时返回空字符串
+func (n *configNode) lookup(path ...string) string {
+	node := n
+	for _, key := range path {
+		node = node.child(key)
+	}
+	if node == nil {
+		return ""
+	}
+	return node.value
+}
+
+// configOutliner 将配置节点转换为符号，并限制输出的符号总数
+type configOutliner struct {
+	lines     []string
+	separator string // 键值分隔符，TOML 为 " = "，其他为 ": "
+	comments  bool   // 是否支持 # 注释
+	count     int
+}
+
+// parseConfigFile 解析 YAML/JSON/TOML 配置文件，输出一级和二级键，并识别常见格式
+func (p *TreeSitterParser) parseConfigFile(filePath string, content []byte, langName string) ([]models.Symbol, string, error) {
+	text := string(content)
+	outliner := &configOutliner{
+		lines:     strings.Split(text, "\n"),
+		separator: ": ",
+		comments:  langName != langJSON,
+	}
+	if langName == langTOML {
+		outliner.separator = " = "
+	}
+
+	purpose := ""
+	if outliner.comments {
+		purpose = leadingHashComment(outliner.lines)
+	}
+
+	// 大型数据文件只保留文件用途
+	if len(content) > maxConfigFileSize {
+		return nil, purpose, nil
+	}
+
+	var documents []*configNode
+	switch langName {
+	case langJSON:
+		// 无法解析的 JSON（如模板文件）不生成大纲
+		if root, err := parseJSONConfig(text); err == nil {
+			documents = []*configNode{root}
+		}
+	case langYAML, langTOML:
+		var err error
+		documents, err = p.parseConfigTree(filePath, content, langName)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	var symbols []models.Symbol
+	var schemaPurpose string
+	if isKubernetesManifest(documents) {
+		symbols, schemaPurpose = outliner.outlineKubernetes(documents)
+	} else {
+		root := &configNode{}
+		for _, document := range documents {
+			root.children = append(root.children, document.children...)
+		}
+		symbols, schemaPurpose = outliner.outlineRoot(filePath, root)
+	}
+
+	if purpose == "" {
+		purpose = schemaPurpose
+	}
+	return symbols, purpose, nil
+}
+
+// parseConfigTree 使用 Tree-sitter 解析 YAML/TOML，返回每个文档的根节点
+func (p *TreeSitterParser) parseConfigTree(filePath string, content []byte, langName string) ([]*configNode, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(getLanguage(langName))
+
+	var documents []*configNode
+	var parseErr error
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				parseErr = fmt.Errorf("解析文件 %s 时发生错误: %v", filePath, r)
+			}
+		}()
+
+		tree, _ := parser.ParseCtx(context.TODO(), nil, content)
+		if tree == nil {
+			parseErr = fmt.Errorf("解析失败: tree is nil")
+			return
+		}
+		defer tree.Close()
+
+		root := tree.RootNode()
+		if langName == langTOML {
+			documents = []*configNode{buildTOMLDocument(root, content)}
+			return
+		}
+
+		// YAML 文件可能包含以 --- 分隔的多个文档
+		for i := 0; i < int(root.NamedChildCount()); i++ {
+			document := root.NamedChild(i)
+			if document == nil || document.Type() != "document" {
+				continue
+			}
+			for j := 0; j < int(document.NamedChildCount()); j++ {
+				child := document.NamedChild(j)
+				if child.Type() == "block_node" || child.Type() == "flow_node" {
+					documents = append(documents, buildYAMLNode(child, content))
+					break
+				}
+			}
+		}
+	}()
+
+	return documents, parseErr
+}
+
+// buildYAMLNode 将 YAML 语法节点转换为配置节点
+func buildYAMLNode(node *sitter.Node, content []byte) *configNode {
+	result := &configNode{
+		startLine: int(node.StartPoint().Row) + 1,
+		endLine:   configEndLine(node),
+	}
+
+	switch node.Type() {
+	case "block_node", "flow_node":
+		var scalar *sitter.Node
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			switch child.Type() {
+			case "block_mapping", "flow_mapping", "block_sequence", "flow_sequence":
+				return buildYAMLNode(child, content)
+			case "anchor", "tag":
+			default:
+				scalar = child
+			}
+		}
+		if scalar != nil {
+			result.value = unquoteConfigValue(scalar.Content(content))
+		}
+
+	case "block_mapping", "flow_mapping":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			pair := node.NamedChild(i)
+			if pair.Type() != "block_mapping_pair" && pair.Type() != "flow_pair" {
+				continue
+			}
+
+			child := &configNode{}
+			if value := pair.ChildByFieldName("value"); value != nil {
+				child = buildYAMLNode(value, content)
+			}
+			if key := pair.ChildByFieldName("key"); key != nil {
+				child.key = unquoteConfigValue(key.Content(content))
+			}
+			child.startLine = int(pair.StartPoint().Row) + 1
+			child.endLine = configEndLine(pair)
+			result.children = append(result.children, child)
+		}
+
+	case "block_sequence", "flow_sequence":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			item := node.NamedChild(i)
+			if item.Type() == "block_sequence_item" {
+				item = item.NamedChild(0)
+			}
+			if item == nil || item.Type() == "comment" {
+				continue
+			}
+			result.children = append(result.children, buildYAMLNode(item, content))
+		}
+
+	default:
+		result.value = unquoteConfigValue(node.Content(content))
+	}
+
+	return result
+}
+
+// configEndLine 返回节点结束行，不计入节点末尾包含的换行
+func configEndLine(node *sitter.Node) int {
+	end := node.EndPoint()
+	if end.Column == 0 && end.Row > node.StartPoint().Row {
+		return int(end.Row)
+	}
+	return int(end.Row) + 1
+}
+
+// buildTOMLDocument 将 TOML 文档转换为配置节点，顶层键值对和每个表都作为一级节点
+func buildTOMLDocument(root *sitter.Node, content []byte) *configNode {
+	document := &configNode{}
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "pair":
+			document.children = append(document.children, buildTOMLPair(child, content))
+		case "table", "table_array_element":
+			table := &configNode{
+				startLine: int(child.StartPoint().Row) + 1,
+				endLine:   configEndLine(child),
+			}
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				member := child.NamedChild(j)
+				switch member.Type() {
+				case "bare_key", "dotted_key", "quoted_key":
+					table.key = member.Content(content)
+				case "pair":
+					table.children = append(table.children, buildTOMLPair(member, content))
+				}
+			}
+			table.header = "[" + table.key + "]"
+			if child.Type() == "table_array_element" {
+				table.header = "[" + table.header + "]"
+			}
+			document.children = append(document.children, table)
+		}
+	}
+
+	return document
+}
+
+// buildTOMLPair 将 TOML 键值对转换为配置节点
+func buildTOMLPair(pair *sitter.Node, content []byte) *configNode {
+	node := &configNode{}
+	if pair.NamedChildCount() >= 2 {
+		node = buildTOMLValue(pair.NamedChild(1), content)
+		node.key = unquoteConfigValue(pair.NamedChild(0).Content(content))
+	}
+	node.startLine = int(pair.StartPoint().Row) + 1
+	node.endLine = configEndLine(pair)
+	return node
+}
+
+// buildTOMLValue 将 TOML 值转换为配置节点，内联表和数组展开为子节点
+func buildTOMLValue(value *sitter.Node, content []byte) *configNode {
+	node := &configNode{
+		startLine: int(value.StartPoint().Row) + 1,
+		endLine:   configEndLine(value),
+	}
+
+	switch value.Type() {
+	case "inline_table":
+		for i := 0; i < int(value.NamedChildCount()); i++ {
+			if pair := value.NamedChild(i); pair.Type() == "pair" {
+				node.children = append(node.children, buildTOMLPair(pair, content))
+			}
+		}
+	case "array":
+		for i := 0; i < int(value.NamedChildCount()); i++ {
+			if item := value.NamedChild(i); item.Type() != "comment" {
+				node.children = append(node.children, buildTOMLValue(item, content))
+			}
+		}
+	default:
+		node.value = unquoteConfigValue(value.Content(content))
+	}
+
+	return node
+}
+
+// parseJSONConfig 解析 JSON（允许 JSONC 的注释和尾随逗号），记录每个键所在的行
+func parseJSONConfig(text string) (*configNode, error) {
+	text = stripJSONComments(text)
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	return decodeJSONNode(decoder, text)
+}
+
+// decodeJSONNode 从解码器读取一个 JSON 值并转换为配置节点
+func decodeJSONNode(decoder *json.Decoder, text string) (*configNode, error) {
+	start := nextJSONTokenOffset(text, int(decoder.InputOffset()))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &configNode{startLine: lineOfOffset(text, start)}
+	if delim, ok := token.(json.Delim); ok {
+		for decoder.More() {
+			keyStart := nextJSONTokenOffset(text, int(decoder.InputOffset()))
+			key := ""
+			if delim == '{' {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, _ = keyToken.(string)
+			}
+
+			child, err := decodeJSONNode(decoder, text)
+			if err != nil {
+				return nil, err
+			}
+			child.key = key
+			child.startLine = lineOfOffset(text, keyStart)
+			node.children = append(node.children, child)
+		}
+
+		// 读取结束的 } 或 ]
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+	} else if token == nil {
+		node.value = "null"
+	} else {
+		node.value = fmt.Sprint(token)
+	}
+
+	node.endLine = lineOfOffset(text, int(decoder.InputOffset()))
+	return node, nil
+}
+
+// nextJSONTokenOffset 跳过空白和分隔符，返回下一个 JSON 记号的偏移
+func nextJSONTokenOffset(text string, offset int) int {
+	for offset < len(text) && strings.IndexByte(" \t\r\n,:", text[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
+
+// stripJSONComments 将 JSONC（如 tsconfig.json）中的注释和尾随逗号替换为空格，保持偏移和行号不变
+func stripJSONComments(text string) string {
+	out := []byte(text)
+
+	// 第一遍去除注释
+	inString := false
+	for i := 0; i < len(out); i++ {
+		switch {
+		case inString:
+			if out[i] == '\\' {
+				i++
+			} else if out[i] == '"' {
+				inString = false
+			}
+		case out[i] == '"':
+			inString = true
+		case out[i] == '/' && i+1 < len(out) && out[i+1] == '/':
+			for ; i < len(out) && out[i] != '\n'; i++ {
+				out[i] = ' '
+			}
+		case out[i] == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := len(out)
+			if idx := strings.Index(string(out[i+2:]), "*/"); idx >= 0 {
+				end = i + 2 + idx + 2
+			}
+			for ; i < end; i++ {
+				if out[i] != '\n' {
+					out[i] = ' '
+				}
+			}
+			i--
+		}
+	}
+
+	// 第二遍去除 } 或 ] 之前的尾随逗号
+	inString = false
+	for i := 0; i < len(out); i++ {
+		switch {
+		case inString:
+			if out[i] == '\\' {
+				i++
+			} else if out[i] == '"' {
+				inString = false
+			}
+		case out[i] == '"':
+			inString = true
+		case out[i] == ',':
+			next := nextJSONTokenOffset(string(out), i+1)
+			if next < len(out) && (out[next] == '}' || out[next] == ']') {
+				out[i] = ' '
+			}
+		}
+	}
+
+	return string(out)
+}
+
+// isKubernetesManifest 检查 YAML 文档是否为 Kubernetes 清单（包含 apiVersion 和 kind）
+func isKubernetesManifest(documents []*configNode) bool {
+	for _, document := range documents {
+		if document.lookup("apiVersion") != "" && document.lookup("kind") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// detectConfigSchema 根据文件名和顶层键识别常见的配置格式
+func detectConfigSchema(filePath string, root *configNode) configSchema {
+	switch {
+	case filepath.Base(filePath) == "package.json":
+		return configSchemaPackageJSON
+	case root.child("paths") != nil && (root.child("openapi") != nil || root.child("swagger") != nil):
+		return configSchemaOpenAPI
+	case root.child("jobs") != nil && root.child("on") != nil:
+		return configSchemaGitHubWorkflow
+	case root.child("services") != nil:
+		if strings.Contains(filepath.Base(filePath), "compose") {
+			return configSchemaCompose
+		}
+		for _, service := range root.child("services").children {
+			if service.child("image") != nil || service.child("build") != nil {
+				return configSchemaCompose
+			}
+		}
+	}
+	return configSchemaGeneric
+}
+
+// outlineKubernetes 每个资源输出为 Kind/name 符号，其余顶层键作为子符号
+func (o *configOutliner) outlineKubernetes(documents []*configNode) ([]models.Symbol, string) {
+	var symbols []models.Symbol
+	var resources []string
+
+	for _, document := range documents {
+		kind := document.lookup("kind")
+		if kind == "" {
+			continue
+		}
+
+		name := kind
+		if resourceName := document.lookup("metadata", "name"); resourceName != "" {
+			name += "/" + resourceName
+		}
+		resources = append(resources, name)
+
+		symbol, ok := o.newSymbol(document, name, o.commentAbove(document.startLine))
+		if !ok {
+			break
+		}
+		if namespace := document.lookup("metadata", "namespace"); namespace != "" && symbol.Purpose == "" {
+			symbol.Purpose = "namespace: " + namespace
+		}
+		for _, child := range document.children {
+			if child.key == "apiVersion" || child.key == "kind" {
+				continue
+			}
+			if childSymbol, ok := o.newSymbol(child, o.prototype(child), o.commentAbove(child.startLine)); ok {
+				symbol.Children = append(symbol.Children, childSymbol)
+			}
+		}
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols, "Kubernetes 清单: " + strings.Join(resources, ", ")
+}
+
+// outlineRoot 输出一级键及其二级键，已识别格式中的关键集合使用专门的展示方式
+func (o *configOutliner) outlineRoot(filePath string, root *configNode) ([]models.Symbol, string) {
+	schema := detectConfigSchema(filePath, root)
+
+	var symbols []models.Symbol
+	for _, node := range root.children {
+		symbol, ok := o.newSymbol(node, o.prototype(node), o.commentAbove(node.startLine))
+		if !ok {
+			break
+		}
+		for _, child := range node.children {
+			childSymbol, ok := o.schemaChildSymbol(schema, node.key, child)
+			if !ok {
+				break
+			}
+			symbol.Children = append(symbol.Children, childSymbol)
+		}
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols, schemaPurpose(schema, root)
+}
+
+// schemaChildSymbol 生成二级键符号：工作流任务、Compose 服务、OpenAPI 路径、npm 脚本和依赖
+func (o *configOutliner) schemaChildSymbol(schema configSchema, parentKey string, node *configNode) (models.Symbol, bool) {
+	prototype := o.prototype(node)
+	purpose := o.commentAbove(node.startLine)
+
+	switch {
+	case schema == configSchemaGitHubWorkflow && parentKey == "jobs":
+		prototype = "job " + node.key
+		if name := node.lookup("name"); name != "" {
+			purpose = name
+		}
+	case schema == configSchemaCompose && parentKey == "services":
+		prototype = "service " + node.key
+		if image := node.lookup("image"); image != "" {
+			purpose = "image: " + image
+		} else if build := node.child("build"); build != nil {
+			purpose = "build: " + o.valueOf(build, "context")
+		}
+	case schema == configSchemaPackageJSON && parentKey == "scripts":
+		prototype = "npm run " + node.key
+		purpose = node.value
+	case schema == configSchemaPackageJSON && containsString(configDependencyKeys, parentKey):
+		prototype = node.key + "@" + node.value
+	}
+
+	symbol, ok := o.newSymbol(node, prototype, purpose)
+	if !ok {
+		return symbol, false
+	}
+
+	// OpenAPI 路径下的每个操作作为子符号
+	if schema == configSchemaOpenAPI && parentKey == "paths" {
+		for _, operation := range node.children {
+			if !containsString(configHTTPMethods, operation.key) {
+				continue
+			}
+			operationPurpose := operation.lookup("summary")
+			if operationPurpose == "" {
+				operationPurpose = operation.lookup("operationId")
+			}
+			operationSymbol, ok := o.newSymbol(operation, strings.ToUpper(operation.key)+" "+node.key, operationPurpose)
+			if !ok {
+				break
+			}
+			symbol.Children = append(symbol.Children, operationSymbol)
+		}
+	}
+
+	return symbol, true
+}
+
+// schemaPurpose 根据识别出的格式生成文件用途
+func schemaPurpose(schema configSchema, root *configNode) string {
+	switch schema {
+	case configSchemaGitHubWorkflow:
+		if name := root.lookup("name"); name != "" {
+			return "GitHub Actions 工作流: " + name
+		}
+		return "GitHub Actions 工作流"
+	case configSchemaCompose:
+		var services []string
+		for _, service := range root.child("services").children {
+			services = append(services, service.key)
+		}
+		return "Docker Compose 服务: " + strings.Join(services, ", ")
+	case configSchemaOpenAPI:
+		title := root.lookup("info", "title")
+		version := root.lookup("openapi")
+		if version == "" {
+			version = root.lookup("swagger")
+		}
+		return strings.TrimSpace("OpenAPI " + version + " 接口定义: " + title)
+	case configSchemaPackageJSON:
+		if description := root.lookup("description"); description != "" {
+			return description
+		}
+		return strings.TrimSuffix("npm 包 "+root.lookup("name")+"@"+root.lookup("version"), "@")
+	}
+	return ""
+}
+
+// newSymbol 创建符号，超过数量上限时返回 false
+func (o *configOutliner) newSymbol(node *configNode, prototype, purpose string) (models.Symbol, bool) {
+	if o.count >= maxConfigSymbols {
+		return models.Symbol{}, false
+	}
+	o.count++
+
+	return models.Symbol{
+		Prototype: prototype,
+		Purpose:   purpose,
+		Range:     []int{node.startLine, node.endLine},
+	}, true
+}
+
+// prototype 生成键的原型：标量显示为 key: value，映射和数组只显示键名，数组元素以 - 开头
+func (o *configOutliner) prototype(node *configNode) string {
+	if node.header != "" {
+		return node.header
+	}
+
+	if node.key == "" {
+		// 数组元素：映射元素显示第一个键值对
+		if len(node.children) > 0 {
+			return "- " + o.prototype(node.children[0])
+		}
+		return "- " + truncateConfigValue(node.value)
+	}
+
+	if len(node.children) > 0 || node.value == "" {
+		return node.key
+	}
+	return node.key + o.separator + truncateConfigValue(node.value)
+}
+
+// valueOf 返回标量节点的值，映射节点返回指定子键的值
+func (o *configOutliner) valueOf(node *configNode, key string) string {
+	if node.value != "" {
+		return node.value
+	}
+	return node.lookup(key)
+}
+
+// commentAbove 提取指定行上方紧邻的 # 注释
+func (o *configOutliner) commentAbove(line int) string {
+	if !o.comments {
+		return ""
+	}
+
+	var commentLines []string
+	for i := line - 2; i >= 0 && i < len(o.lines); i-- {
+		trimmed := strings.TrimSpace(o.lines[i])
+		if !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+		if comment := strings.TrimSpace(strings.TrimLeft(trimmed, "#")); comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// leadingHashComment 提取文件开头的 # 注释块作为文件用途（紧贴第一个键的注释属于该键）
+func leadingHashComment(lines []string) string {
+	var commentLines []string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if len(commentLines) > 0 {
+				return strings.Join(commentLines, " ")
+			}
+			continue
+		}
+		if trimmed == "---" && len(commentLines) == 0 {
+			continue
+		}
+		if !strings.HasPrefix(trimmed, "#") {
+			return ""
+		}
+
+		comment := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+		// 跳过编辑器和 schema 指令
+		if comment != "" && !strings.HasPrefix(comment, "yaml-language-server:") && !strings.Contains(comment, "vim:") {
+			commentLines = append(commentLines, comment)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// unquoteConfigValue 去除标量两端的引号并合并空白
+func unquoteConfigValue(text string) string {
+	text = strings.TrimSpace(text)
+	if len(text) >= 2 && (text[0] == '"' || text[0] == '\'') && text[len(text)-1] == text[0] {
+		text = text[1 : len(text)-1]
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// truncateConfigValue 截断过长的标量值
+func truncateConfigValue(value string) string {
+	runes := []rune(value)
+	if len(runes) > maxConfigValueLength {
+		return string(runes[:maxConfigValueLength]) + "..."
+	}
+	return value
+}
+
+// containsString 检查字符串切片是否包含指定字符串
+func containsString(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
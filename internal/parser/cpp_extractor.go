@@ -7,6 +7,20 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+// cppKindDeclaration 标记没有函数体的函数声明（如头文件中的原型），由项目级的声明/实现配对使用
+const cppKindDeclaration = "declaration"
+
+// cppTypeSpecifiers 带有成员列表的类型定义
+var cppTypeSpecifiers = map[string]bool{
+	"class_specifier":  true,
+	"struct_specifier": true,
+	"union_specifier":  true,
+	"enum_specifier":   true,
+}
+
+// cppDeclarationQualifiers 声明前记录为修饰符的类型限定符（const、volatile 修饰的是返回类型，不记录）
+var cppDeclarationQualifiers = map[string]bool{"constexpr": true, "consteval": true, "constinit": true}
+
 // CppExtractor C++语言提取器
 type CppExtractor struct {
 	BaseExtractor
@@ -32,206 +46,408 @@ func (c *CppExtractor) GetQueries() []string {
 	return c.queries
 }
 
-// ExtractPrototype 提取C++类原型
+// ExtractPrototype 提取C++声明原型，模板声明保留 template<...> 参数列表
 func (c *CppExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
-	nodeType := node.Type()
+	switch node.Type() {
+	case "template_declaration":
+		inner := c.templateInner(node)
+		if inner == nil {
+			return c.extractFullNode(node, content)
+		}
+		header := c.cleanText(string(content[node.StartByte():inner.StartByte()]))
+		return header + " " + c.ExtractPrototype(inner, content)
 
-	// 对于类声明，只提取类声明部分（不包含类体）
-	if nodeType == "class_specifier" || nodeType == "struct_specifier" {
+	case "class_specifier", "struct_specifier", "union_specifier", "enum_specifier":
+		// 对于类声明，只提取类声明部分（不包含类体）
 		return c.extractClassPrototype(node, content)
-	}
 
-	// 对于命名空间，只提取命名空间声明
-	if nodeType == "namespace_definition" {
+	case "namespace_definition":
+		// 对于命名空间，只提取命名空间声明
 		return c.extractNamespacePrototype(node, content)
-	}
 
-	// 对于函数定义，提取函数签名
-	if nodeType == "function_definition" {
-		return c.extractFunctionPrototype(node, content, c.IsFunctionBodyNode)
+	case "function_definition":
+		// 对于函数定义，提取函数签名；= default / = delete 等没有函数体的定义保留完整声明
+		if prototype := c.extractFunctionPrototype(node, content, c.IsFunctionBodyNode); prototype != "" {
+			return prototype
+		}
 	}
 
-	return c.extractFullNode(node, content)
+	return strings.TrimSuffix(c.extractFullNode(node, content), ";")
 }
 
-// ExtractMethods 提取C++类内部的方法或命名空间内部的类
+// ExtractMethods 提取C++类内部的方法或命名空间内部的声明
 func (c *CppExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
-	var methods []models.Symbol
-	nodeType := classNode.Type()
-
-	// 如果是命名空间，提取命名空间内部的类
-	if nodeType == "namespace_definition" {
-		return c.extractNamespaceClasses(classNode, content)
+	if classNode.Type() == "namespace_definition" {
+		if body := classNode.ChildByFieldName("body"); body != nil {
+			return c.extractDeclarations(body, nil, content)
+		}
+		return nil
 	}
+	methods, _ := c.extractClassMembers(classNode, nil, content)
+	return methods
+}
 
-	// 如果是类，提取类内部的方法
-	childCount := int(classNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := classNode.Child(i)
-		if child == nil {
-			continue
+// IsClassNode 检查是否是类节点或命名空间节点
+func (c *CppExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "class_specifier" ||
+		nodeType == "struct_specifier" ||
+		nodeType == "union_specifier" ||
+		nodeType == "enum_specifier" ||
+		nodeType == "namespace_definition"
+}
+
+// IsFunctionBodyNode 检查是否是函数体节点（构造函数的成员初始化列表也不属于原型）
+func (c *CppExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "compound_statement" || nodeType == "block" || nodeType == "field_initializer_list"
+}
+
+// IsInsideClass 检查节点是否在类内部
+func (c *CppExtractor) IsInsideClass(node *sitter.Node) bool {
+	current := node.Parent()
+	for current != nil {
+		nodeType := current.Type()
+		if nodeType == "class_specifier" ||
+			nodeType == "struct_specifier" ||
+			nodeType == "union_specifier" ||
+			nodeType == "enum_specifier" ||
+			nodeType == "namespace_definition" {
+			return true
 		}
+		current = current.Parent()
+	}
+	return false
+}
 
-		childType := child.Type()
-		// 查找类体
-		if childType == "field_declaration_list" {
-			// 遍历类体中的方法
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
+// ExtractComments 提取C++注释
+func (c *CppExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	return extractMultiLineComments(node, content)
+}
+
+// ExtractSymbols 按命名空间层级提取声明：头文件中没有函数体的函数声明标记为 declaration，
+// 函数（包括类外定义的 Foo::bar）记录限定名称，用于在项目级视图中把声明与实现配对
+func (c *CppExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	return c.extractDeclarations(root, nil, content)
+}
+
+// extractDeclarations 提取翻译单元、命名空间或 extern "C" 块中的声明，scope 为外层命名空间
+func (c *CppExtractor) extractDeclarations(list *sitter.Node, scope []string, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		child := list.NamedChild(i)
 
-				bodyChildType := bodyChild.Type()
-				if bodyChildType == "function_definition" {
-					method := c.createMethodSymbol(bodyChild, content)
-					methods = append(methods, method)
+		switch child.Type() {
+		case "namespace_definition":
+			symbol := c.createSymbol(child, child, content)
+			if body := child.ChildByFieldName("body"); body != nil {
+				innerScope := scope
+				if name := child.ChildByFieldName("name"); name != nil {
+					innerScope = appendScope(scope, name.Content(content))
 				}
+				symbol.Children = c.extractDeclarations(body, innerScope, content)
+			}
+			symbols = append(symbols, symbol)
+
+		case "linkage_specification":
+			// extern "C" { ... } 中的声明属于外层作用域
+			if body := child.ChildByFieldName("body"); body != nil {
+				if body.Type() == "declaration_list" {
+					symbols = append(symbols, c.extractDeclarations(body, scope, content)...)
+				} else if symbol, ok := c.createDeclarationSymbol(body, child, scope, content); ok {
+					symbols = append(symbols, symbol)
+				}
+			}
+
+		default:
+			if symbol, ok := c.createDeclarationSymbol(child, child, scope, content); ok {
+				symbols = append(symbols, symbol)
 			}
 		}
 	}
 
-	return methods
+	return symbols
 }
 
-// extractNamespaceClasses 提取命名空间内部的类
-func (c *CppExtractor) extractNamespaceClasses(namespaceNode *sitter.Node, content []byte) []models.Symbol {
-	var classes []models.Symbol
+// createDeclarationSymbol 为类型定义、函数定义和函数声明创建符号，其他声明（变量、using 等）返回 false。
+// rangeNode 为符号范围和注释的起点（模板声明或 extern "C" 声明）
+func (c *CppExtractor) createDeclarationSymbol(node, rangeNode *sitter.Node, scope []string, content []byte) (models.Symbol, bool) {
+	switch node.Type() {
+	case "template_declaration":
+		inner := c.templateInner(node)
+		if inner == nil {
+			return models.Symbol{}, false
+		}
+		symbol, ok := c.createDeclarationSymbol(inner, rangeNode, scope, content)
+		if ok {
+			symbol.Prototype = c.ExtractPrototype(node, content)
+			symbol.TypeParams = c.extractTemplateParams(node.ChildByFieldName("parameters"), content)
+		}
+		return symbol, ok
 
-	childCount := int(namespaceNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := namespaceNode.Child(i)
-		if child == nil {
-			continue
+	case "class_specifier", "struct_specifier", "union_specifier", "enum_specifier":
+		if node.ChildByFieldName("body") == nil {
+			return models.Symbol{}, false
 		}
+		return c.createTypeSymbol(node, rangeNode, scope, content), true
+
+	case "function_definition":
+		symbol := c.createSymbol(node, rangeNode, content)
+		symbol.QualifiedName = c.qualifiedFunctionName(node, scope, content)
+		symbol.Visibility = c.linkageVisibility(symbol.Modifiers)
+		return symbol, true
+
+	case "declaration", "field_declaration":
+		// class Foo { ... } x; 这类声明中的类型定义
+		if typeNode := node.ChildByFieldName("type"); typeNode != nil && cppTypeSpecifiers[typeNode.Type()] {
+			if typeNode.ChildByFieldName("body") != nil {
+				return c.createTypeSymbol(typeNode, rangeNode, scope, content), true
+			}
+		}
+		if c.functionDeclarator(node) == nil {
+			return models.Symbol{}, false
+		}
+		symbol := c.createSymbol(node, rangeNode, content)
+		symbol.Kind = cppKindDeclaration
+		symbol.QualifiedName = c.qualifiedFunctionName(node, scope, content)
+		symbol.Visibility = c.linkageVisibility(symbol.Modifiers)
+		return symbol, true
+	}
 
-		childType := child.Type()
-		// 查找命名空间体
-		if childType == "declaration_list" {
-			// 遍历命名空间体中的类
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
+	return models.Symbol{}, false
+}
+
+// createTypeSymbol 创建类、结构体、联合体或枚举符号：成员函数放入 Methods，嵌套类型和枚举值放入 Children
+func (c *CppExtractor) createTypeSymbol(node, rangeNode *sitter.Node, scope []string, content []byte) models.Symbol {
+	symbol := c.createSymbol(node, rangeNode, content)
+	symbol.Visibility = visibilityPublic
 
-				bodyChildType := bodyChild.Type()
-				// C++: class_specifier, struct_specifier
-				if bodyChildType == "class_specifier" || bodyChildType == "struct_specifier" {
-					class := c.createClassSymbol(bodyChild, content)
-					classes = append(classes, class)
+	if node.Type() == "enum_specifier" {
+		if body := node.ChildByFieldName("body"); body != nil {
+			for i := 0; i < int(body.NamedChildCount()); i++ {
+				if enumerator := body.NamedChild(i); enumerator.Type() == "enumerator" {
+					symbol.Children = append(symbol.Children, c.createSymbol(enumerator, enumerator, content))
 				}
 			}
 		}
+		return symbol
 	}
 
-	return classes
+	innerScope := scope
+	if name := node.ChildByFieldName("name"); name != nil {
+		innerScope = appendScope(scope, c.unqualifiedName(name, content))
+	}
+	symbol.Methods, symbol.Children = c.extractClassMembers(node, innerScope, content)
+	return symbol
 }
 
-// createClassSymbol 创建类符号
-func (c *CppExtractor) createClassSymbol(node *sitter.Node, content []byte) models.Symbol {
-	start := node.StartPoint()
-	end := node.EndPoint()
+// extractClassMembers 提取类体中的成员函数（定义和声明）以及嵌套类型，scope 包含类名本身。
+// 成员的可见性取自前面最近的 public:/protected:/private:，class 默认为 private，struct 和 union 默认为 public
+func (c *CppExtractor) extractClassMembers(classNode *sitter.Node, scope []string, content []byte) (methods, children []models.Symbol) {
+	body := classNode.ChildByFieldName("body")
+	if body == nil {
+		return nil, nil
+	}
+
+	access := visibilityPublic
+	if classNode.Type() == "class_specifier" {
+		access = visibilityPrivate
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		if member.Type() == "access_specifier" {
+			access = member.Content(content)
+			continue
+		}
+		symbol, ok := c.createDeclarationSymbol(member, member, scope, content)
+		if !ok {
+			continue
+		}
+		symbol.Visibility = access
+		if symbol.QualifiedName != "" {
+			methods = append(methods, symbol)
+		} else {
+			children = append(children, symbol)
+		}
+	}
+
+	return methods, children
+}
 
-	prototype := c.extractClassPrototype(node, content)
+// createSymbol 创建基础符号，原型取自 node，范围和注释取自 rangeNode
+func (c *CppExtractor) createSymbol(node, rangeNode *sitter.Node, content []byte) models.Symbol {
+	start := rangeNode.StartPoint()
+	end := rangeNode.EndPoint()
 
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   extractMultiLineComments(node, content),
+	symbol := models.Symbol{
+		Prototype: c.ExtractPrototype(node, content),
+		Purpose:   c.extractCppComments(rangeNode, content),
 		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
-		Methods:   c.extractClassMethods(node, content),
+		Modifiers: c.extractModifiers(node, content),
+	}
+	if declarator := c.functionDeclarator(node); declarator != nil {
+		symbol.Params = cParams(declarator.ChildByFieldName("parameters"), content)
+		symbol.Returns = cReturns(node, declarator, content)
 	}
+	return symbol
 }
 
-// extractClassMethods 提取类内部的方法
-func (c *CppExtractor) extractClassMethods(classNode *sitter.Node, content []byte) []models.Symbol {
-	var methods []models.Symbol
+// extractTemplateParams 解析模板参数：typename... Ts 记为 ...Ts，非类型参数（int N）的类型作为约束
+func (c *CppExtractor) extractTemplateParams(list *sitter.Node, content []byte) []models.TypeParam {
+	if list == nil {
+		return nil
+	}
 
-	childCount := int(classNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := classNode.Child(i)
-		if child == nil {
-			continue
+	var typeParams []models.TypeParam
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		node := list.NamedChild(i)
+		switch node.Type() {
+		case "type_parameter_declaration", "variadic_type_parameter_declaration":
+			typeParam := models.TypeParam{Name: nodeText(node.NamedChild(int(node.NamedChildCount())-1), content)}
+			if node.Type() == "variadic_type_parameter_declaration" {
+				typeParam.Name = "..." + typeParam.Name
+			}
+			typeParams = append(typeParams, typeParam)
+		case "optional_type_parameter_declaration":
+			typeParams = append(typeParams, models.TypeParam{
+				Name:    fieldText(node, "name", content),
+				Default: fieldText(node, "default_type", content),
+			})
+		case "parameter_declaration", "optional_parameter_declaration", "variadic_parameter_declaration":
+			param := cParam(node, content)
+			typeParam := models.TypeParam{Name: param.Name, Constraint: param.Type, Default: param.Default}
+			if param.Variadic {
+				typeParam.Name = "..." + typeParam.Name
+			}
+			typeParams = append(typeParams, typeParam)
+		case "template_template_parameter_declaration":
+			typeParams = append(typeParams, models.TypeParam{Name: nodeText(node, content)})
 		}
+	}
+	return typeParams
+}
 
-		childType := child.Type()
-		// 查找类体
-		if childType == "field_declaration_list" {
-			// 遍历类体中的方法
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
-
-				bodyChildType := bodyChild.Type()
-				if bodyChildType == "function_definition" {
-					method := c.createMethodSymbol(bodyChild, content)
-					methods = append(methods, method)
-				}
+// extractModifiers 提取函数声明上的修饰符：存储类说明符（static、inline、extern）、virtual、explicit、
+// constexpr，声明符后的 const 成员限定、override/final，纯虚函数（= 0）记录为 abstract
+func (c *CppExtractor) extractModifiers(node *sitter.Node, content []byte) []string {
+	var modifiers []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "storage_class_specifier", "virtual":
+			modifiers = appendModifier(modifiers, child.Content(content))
+		case "explicit_function_specifier":
+			modifiers = appendModifier(modifiers, "explicit")
+		case "type_qualifier":
+			if word := child.Content(content); cppDeclarationQualifiers[word] {
+				modifiers = appendModifier(modifiers, word)
 			}
+		case "pure_virtual_clause":
+			modifiers = appendModifier(modifiers, "abstract")
 		}
 	}
 
-	return methods
+	if declarator := c.functionDeclarator(node); declarator != nil {
+		for i := 0; i < int(declarator.NamedChildCount()); i++ {
+			child := declarator.NamedChild(i)
+			switch child.Type() {
+			case "type_qualifier", "virtual_specifier":
+				modifiers = appendModifier(modifiers, child.Content(content))
+			}
+		}
+	}
+	return modifiers
 }
 
-// IsClassNode 检查是否是类节点或命名空间节点
-func (c *CppExtractor) IsClassNode(nodeType string) bool {
-	return nodeType == "class_specifier" ||
-		nodeType == "struct_specifier" ||
-		nodeType == "union_specifier" ||
-		nodeType == "enum_specifier" ||
-		nodeType == "namespace_definition"
+// linkageVisibility 返回命名空间作用域中函数的可见性：static 函数只在本编译单元内可见
+func (c *CppExtractor) linkageVisibility(modifiers []string) string {
+	for _, modifier := range modifiers {
+		if modifier == "static" {
+			return visibilityPrivate
+		}
+	}
+	return visibilityPublic
 }
 
-// IsFunctionBodyNode 检查是否是函数体节点
-func (c *CppExtractor) IsFunctionBodyNode(nodeType string) bool {
-	return nodeType == "compound_statement" || nodeType == "block"
+// templateInner 返回模板声明包装的声明节点
+func (c *CppExtractor) templateInner(node *sitter.Node) *sitter.Node {
+	for i := int(node.NamedChildCount()) - 1; i >= 0; i-- {
+		child := node.NamedChild(i)
+		if child.Type() != "template_parameter_list" && child.Type() != "comment" {
+			return child
+		}
+	}
+	return nil
 }
 
-// IsInsideClass 检查节点是否在类内部
-func (c *CppExtractor) IsInsideClass(node *sitter.Node) bool {
-	current := node.Parent()
-	for current != nil {
-		nodeType := current.Type()
-		if nodeType == "class_specifier" ||
-			nodeType == "struct_specifier" ||
-			nodeType == "union_specifier" ||
-			nodeType == "enum_specifier" ||
-			nodeType == "namespace_definition" {
-			return true
+// functionDeclarator 沿着指针、引用声明符查找函数声明符，不是函数时返回 nil
+func (c *CppExtractor) functionDeclarator(node *sitter.Node) *sitter.Node {
+	declarator := node.ChildByFieldName("declarator")
+	for declarator != nil {
+		switch declarator.Type() {
+		case "function_declarator":
+			return declarator
+		case "pointer_declarator", "reference_declarator":
+			next := declarator.ChildByFieldName("declarator")
+			if next == nil && declarator.NamedChildCount() > 0 {
+				next = declarator.NamedChild(int(declarator.NamedChildCount()) - 1)
+			}
+			declarator = next
+		default:
+			return nil
 		}
-		current = current.Parent()
 	}
-	return false
+	return nil
 }
 
-// ExtractComments 提取C++注释
-func (c *CppExtractor) ExtractComments(node *sitter.Node, content []byte) string {
-	return extractMultiLineComments(node, content)
+// qualifiedFunctionName 返回函数的限定名称（外层命名空间和类 + 声明中的限定符），
+// 限定符中的模板参数会被去掉，如 Widget<T>::render 记为 Widget::render
+func (c *CppExtractor) qualifiedFunctionName(node *sitter.Node, scope []string, content []byte) string {
+	declarator := c.functionDeclarator(node)
+	if declarator == nil {
+		return ""
+	}
+	name := declarator.ChildByFieldName("declarator")
+	if name == nil {
+		return ""
+	}
+	return strings.Join(appendScope(scope, c.unqualifiedName(name, content)), "::")
 }
 
-// extractClassPrototype 提取C++类原型
-func (c *CppExtractor) extractClassPrototype(node *sitter.Node, content []byte) string {
-	childCount := int(node.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := node.Child(i)
-		if child == nil {
-			continue
+// unqualifiedName 把名称节点转换为不含模板参数的文本，保留 :: 限定符
+func (c *CppExtractor) unqualifiedName(node *sitter.Node, content []byte) string {
+	switch node.Type() {
+	case "qualified_identifier":
+		name := node.ChildByFieldName("name")
+		if name == nil {
+			return c.cleanText(node.Content(content))
+		}
+		if scope := node.ChildByFieldName("scope"); scope != nil {
+			return c.unqualifiedName(scope, content) + "::" + c.unqualifiedName(name, content)
 		}
+		return "::" + c.unqualifiedName(name, content)
 
-		childType := child.Type()
-		// 查找类体开始位置
-		if childType == "field_declaration_list" || childType == "{" {
-			prototype := string(content[node.StartByte():child.StartByte()])
-			return strings.TrimSpace(prototype)
+	case "template_type", "template_function":
+		if name := node.ChildByFieldName("name"); name != nil {
+			return name.Content(content)
 		}
 	}
-	return ""
+	return strings.Join(strings.Fields(node.Content(content)), " ")
+}
+
+// appendScope 返回追加了名称的新作用域切片，不修改原切片
+func appendScope(scope []string, name string) []string {
+	result := make([]string, 0, len(scope)+1)
+	result = append(result, scope...)
+	return append(result, name)
+}
+
+// extractClassPrototype 提取C++类原型
+func (c *CppExtractor) extractClassPrototype(node *sitter.Node, content []byte) string {
+	if body := node.ChildByFieldName("body"); body != nil {
+		return c.cleanText(string(content[node.StartByte():body.StartByte()]))
+	}
+	return c.extractFullNode(node, content)
 }
 
 // extractNamespacePrototype 提取C++命名空间原型
@@ -253,20 +469,6 @@ func (c *CppExtractor) extractNamespacePrototype(node *sitter.Node, content []by
 	return ""
 }
 
-// createMethodSymbol 创建方法符号
-func (c *CppExtractor) createMethodSymbol(node *sitter.Node, content []byte) models.Symbol {
-	start := node.StartPoint()
-	end := node.EndPoint()
-
-	prototype := c.extractFunctionPrototype(node, content, c.IsFunctionBodyNode)
-
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   c.extractCppComments(node, content),
-		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
-	}
-}
-
 // extractCppComments 提取C++注释
 func (c *CppExtractor) extractCppComments(node *sitter.Node, content []byte) string {
 	startPoint := node.StartPoint()
@@ -288,6 +490,18 @@ func (c *CppExtractor) extractCppComments(node *sitter.Node, content []byte) str
 
 		// 检查多行注释结束
 		if strings.HasSuffix(line, "*/") {
+			// 单行的 /* ... */ 注释
+			if strings.HasPrefix(line, "/*") {
+				comment := strings.TrimSpace(strings.TrimLeft(strings.TrimSuffix(line, "*/"), "/*"))
+				if comment != "" {
+					commentLines = append([]string{comment}, commentLines...)
+				}
+				break
+			}
+			// 代码行末尾的注释属于该行代码
+			if strings.Contains(line, "/*") {
+				break
+			}
 			inMultiLineComment = true
 			comment := strings.TrimSpace(strings.TrimSuffix(line, "*/"))
 			if comment != "" {
@@ -306,7 +520,7 @@ func (c *CppExtractor) extractCppComments(node *sitter.Node, content []byte) str
 				continue
 			} else if strings.HasPrefix(line, "/*") {
 				// 多行注释开始
-				comment := strings.TrimSpace(strings.TrimPrefix(line, "/*"))
+				comment := strings.TrimSpace(strings.TrimLeft(line, "/*"))
 				if comment != "" {
 					commentLines = append([]string{comment}, commentLines...)
 				}
@@ -317,7 +531,7 @@ func (c *CppExtractor) extractCppComments(node *sitter.Node, content []byte) str
 
 		// 检查单行注释
 		if strings.HasPrefix(line, "//") {
-			comment := strings.TrimSpace(strings.TrimPrefix(line, "//"))
+			comment := strings.TrimSpace(strings.TrimLeft(line, "/"))
 			if comment != "" {
 				return comment
 			}
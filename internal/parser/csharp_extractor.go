@@ -7,6 +7,43 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+// csharpTypeDeclarations 可以包含成员的类型声明
+var csharpTypeDeclarations = map[string]bool{
+	"class_declaration":         true,
+	"struct_declaration":        true,
+	"interface_declaration":     true,
+	"enum_declaration":          true,
+	"record_declaration":        true,
+	"record_struct_declaration": true,
+}
+
+// csharpMethodMembers 作为方法输出的类型成员
+var csharpMethodMembers = map[string]bool{
+	"method_declaration":              true,
+	"constructor_declaration":         true,
+	"destructor_declaration":          true,
+	"operator_declaration":            true,
+	"conversion_operator_declaration": true,
+	"indexer_declaration":             true,
+}
+
+// csharpChildMembers 作为子符号输出的类型成员
+var csharpChildMembers = map[string]bool{
+	"field_declaration":       true,
+	"property_declaration":    true,
+	"event_field_declaration": true,
+	"event_declaration":       true,
+	"delegate_declaration":    true,
+	"enum_member_declaration": true,
+}
+
+// csharpModifierKeywords 记录为修饰符的关键字（访问修饰符单独记录在 visibility 字段中）
+var csharpModifierKeywords = map[string]bool{
+	"static": true, "abstract": true, "virtual": true, "override": true, "sealed": true, "async": true,
+	"readonly": true, "const": true, "extern": true, "unsafe": true, "partial": true, "new": true,
+	"volatile": true, "required": true, "file": true,
+}
+
 // CSharpExtractor C#语言提取器
 type CSharpExtractor struct {
 	BaseExtractor
@@ -32,206 +69,427 @@ func (c *CSharpExtractor) GetQueries() []string {
 	return c.queries
 }
 
-// ExtractPrototype 提取C#类原型
+// ExtractPrototype 提取C#声明原型（不包含类体、方法体和访问器体）
 func (c *CSharpExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
-	nodeType := node.Type()
+	switch node.Type() {
+	case "namespace_declaration":
+		// 对于命名空间，只提取命名空间声明
+		return c.extractNamespacePrototype(node, content)
 
-	// 对于类声明，只提取类声明部分（不包含类体）
-	if nodeType == "class_declaration" {
-		return c.extractClassPrototype(node, content)
-	}
+	case "class_declaration", "struct_declaration", "interface_declaration", "enum_declaration",
+		"record_declaration", "record_struct_declaration":
+		// 对于类型声明，只提取声明部分（不包含类体）
+		if body := node.ChildByFieldName("body"); body != nil {
+			return c.cleanText(string(content[node.StartByte():body.StartByte()]))
+		}
 
-	// 对于命名空间，只提取命名空间声明
-	if nodeType == "namespace_declaration" {
-		return c.extractNamespacePrototype(node, content)
-	}
+	case "method_declaration", "constructor_declaration", "destructor_declaration",
+		"operator_declaration", "conversion_operator_declaration":
+		// 对于方法声明，提取方法签名（块体或 => 表达式体之前的部分）
+		if body := node.ChildByFieldName("body"); body != nil {
+			return c.cleanText(string(content[node.StartByte():body.StartByte()]))
+		}
+
+	case "property_declaration", "indexer_declaration", "event_declaration":
+		// 属性保留访问器列表（如 { get; private set; }），去掉访问器体和初始值
+		accessors := node.ChildByFieldName("accessors")
+		end := node.EndByte()
+		if accessors != nil {
+			end = accessors.StartByte()
+		} else if value := node.ChildByFieldName("value"); value != nil {
+			end = value.StartByte()
+		}
+		prototype := c.cleanText(string(content[node.StartByte():end]))
+		if accessors != nil {
+			prototype += " " + c.extractAccessors(accessors, content)
+		}
+		return prototype
 
-	// 对于方法声明，提取方法签名
-	if nodeType == "method_declaration" {
-		return c.extractMethodPrototype(node, content)
+	case "field_declaration", "event_field_declaration":
+		// 字段的初始值可能很长，超出部分截断
+		return truncateConfigValue(strings.TrimSuffix(c.extractFullNode(node, content), ";"))
 	}
 
-	return c.extractFullNode(node, content)
+	return strings.TrimSuffix(c.extractFullNode(node, content), ";")
 }
 
-// ExtractMethods 提取C#类内部的方法或命名空间内部的类
+// ExtractMethods 提取C#类内部的方法或命名空间内部的类型
 func (c *CSharpExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
-	var methods []models.Symbol
-	nodeType := classNode.Type()
-
-	// 如果是命名空间，提取命名空间内部的类
-	if nodeType == "namespace_declaration" {
-		return c.extractNamespaceClasses(classNode, content)
+	body := classNode.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	if classNode.Type() == "namespace_declaration" {
+		return c.extractDeclarations(body, nil, content)
 	}
 
-	// 如果是类，提取类内部的方法
-	childCount := int(classNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := classNode.Child(i)
-		if child == nil {
-			continue
+	var symbol models.Symbol
+	c.extractMembers(body, nil, content, &symbol)
+	return symbol.Methods
+}
+
+// IsClassNode 检查是否是类节点或命名空间节点
+func (c *CSharpExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "class_declaration" ||
+		nodeType == "interface_declaration" ||
+		nodeType == "enum_declaration" ||
+		nodeType == "namespace_declaration"
+}
+
+// IsFunctionBodyNode 检查是否是函数体节点
+func (c *CSharpExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "block" || nodeType == "block_statement" || nodeType == "arrow_expression_clause"
+}
+
+// IsInsideClass 检查节点是否在类内部
+func (c *CSharpExtractor) IsInsideClass(node *sitter.Node) bool {
+	current := node.Parent()
+	for current != nil {
+		nodeType := current.Type()
+		if nodeType == "class_declaration" ||
+			nodeType == "interface_declaration" ||
+			nodeType == "enum_declaration" ||
+			nodeType == "namespace_declaration" {
+			return true
 		}
+		current = current.Parent()
+	}
+	return false
+}
 
-		childType := child.Type()
-		// 查找类体或声明列表
-		if childType == "class_body" || childType == "declaration_list" {
-			// 遍历类体中的方法
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
+// ExtractComments 提取C#注释
+func (c *CSharpExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	return extractXMLDocComments(node, content)
+}
+
+// ExtractSymbols 按命名空间层级提取类型声明：方法、构造函数、运算符和索引器放入 Methods，
+// 字段、属性、事件、委托、枚举成员和嵌套类型放入 Children；特性记录在 annotations 字段中，
+// XML 文档注释的 <param>/<returns> 解析到 doc 字段。分部类型记录限定名称，用于在项目级视图中合并
+func (c *CSharpExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	return c.extractDeclarations(root, nil, content)
+}
 
-				bodyChildType := bodyChild.Type()
-				if bodyChildType == "method_declaration" || bodyChildType == "constructor_declaration" {
-					method := c.createMethodSymbol(bodyChild, content)
-					methods = append(methods, method)
+// extractDeclarations 提取编译单元或命名空间中的声明，scope 为外层命名空间和类型名称。
+// 文件范围的命名空间（namespace Foo;）之后的声明作为该命名空间的子符号
+func (c *CSharpExtractor) extractDeclarations(list *sitter.Node, scope []string, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		child := list.NamedChild(i)
+
+		switch child.Type() {
+		case "namespace_declaration":
+			symbol := c.createSymbol(child, content)
+			if body := child.ChildByFieldName("body"); body != nil {
+				symbol.Children = c.extractDeclarations(body, c.namespaceScope(child, scope, content), content)
+			}
+			symbols = append(symbols, symbol)
+
+		case "file_scoped_namespace_declaration":
+			symbol := c.createSymbol(child, content)
+			innerScope := c.namespaceScope(child, scope, content)
+			for i++; i < int(list.NamedChildCount()); i++ {
+				if member, ok := c.createDeclarationSymbol(list.NamedChild(i), innerScope, content); ok {
+					symbol.Children = append(symbol.Children, member)
 				}
 			}
+			if end := list.NamedChild(int(list.NamedChildCount()) - 1); end != nil {
+				symbol.Range[1] = int(end.EndPoint().Row) + 1
+			}
+			symbols = append(symbols, symbol)
+
+		default:
+			if symbol, ok := c.createDeclarationSymbol(child, scope, content); ok {
+				symbols = append(symbols, symbol)
+			}
 		}
 	}
 
-	return methods
+	return symbols
 }
 
-// extractNamespaceClasses 提取命名空间内部的类
-func (c *CSharpExtractor) extractNamespaceClasses(namespaceNode *sitter.Node, content []byte) []models.Symbol {
-	var classes []models.Symbol
+// createDeclarationSymbol 为命名空间中的类型或委托创建符号，其他节点返回 false。
+// 没有访问修饰符的顶层类型为 internal；分部类型的可见性可以由其他部分声明，留空由项目级合并时确定
+func (c *CSharpExtractor) createDeclarationSymbol(node *sitter.Node, scope []string, content []byte) (models.Symbol, bool) {
+	var symbol models.Symbol
+	switch {
+	case csharpTypeDeclarations[node.Type()]:
+		symbol = c.createTypeSymbol(node, scope, content)
+	case node.Type() == "delegate_declaration":
+		symbol = c.createSymbol(node, content)
+	default:
+		return models.Symbol{}, false
+	}
+	if symbol.Visibility == "" && symbol.QualifiedName == "" {
+		symbol.Visibility = visibilityInternal
+	}
+	return symbol, true
+}
 
-	childCount := int(namespaceNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := namespaceNode.Child(i)
-		if child == nil {
-			continue
+// createTypeSymbol 创建类型符号，递归提取成员和嵌套类型
+func (c *CSharpExtractor) createTypeSymbol(node *sitter.Node, scope []string, content []byte) models.Symbol {
+	symbol := c.createSymbol(node, content)
+
+	innerScope := scope
+	if name := node.ChildByFieldName("name"); name != nil {
+		innerScope = appendScope(scope, name.Content(content))
+		if c.hasModifier(node, "partial", content) {
+			symbol.QualifiedName = strings.Join(innerScope, ".")
 		}
+	}
 
-		childType := child.Type()
-		// 查找命名空间体
-		if childType == "declaration_list" {
-			// 遍历命名空间体中的类
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
+	if body := node.ChildByFieldName("body"); body != nil {
+		c.extractMembers(body, innerScope, content, &symbol)
+	}
+	return symbol
+}
 
-				bodyChildType := bodyChild.Type()
-				// C#: class_declaration, interface_declaration, enum_declaration
-				if bodyChildType == "class_declaration" ||
-					bodyChildType == "interface_declaration" ||
-					bodyChildType == "enum_declaration" {
-					class := c.createClassSymbol(bodyChild, content)
-					classes = append(classes, class)
-				}
-			}
-		}
+// extractMembers 提取类型体中的成员。没有访问修饰符的成员在接口和枚举中为 public，在类和结构体中为 private，分部类型除外
+func (c *CSharpExtractor) extractMembers(body *sitter.Node, scope []string, content []byte, symbol *models.Symbol) {
+	defaultVisibility := visibilityPrivate
+	if parentType := body.Parent().Type(); parentType == "interface_declaration" || parentType == "enum_declaration" {
+		defaultVisibility = visibilityPublic
 	}
 
-	return classes
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		memberType := member.Type()
+
+		var memberSymbol models.Symbol
+		switch {
+		case csharpMethodMembers[memberType], csharpChildMembers[memberType]:
+			memberSymbol = c.createSymbol(member, content)
+		case csharpTypeDeclarations[memberType]:
+			memberSymbol = c.createTypeSymbol(member, scope, content)
+		default:
+			continue
+		}
+		if memberSymbol.Visibility == "" && memberSymbol.QualifiedName == "" {
+			memberSymbol.Visibility = defaultVisibility
+		}
+
+		if csharpMethodMembers[memberType] {
+			symbol.Methods = append(symbol.Methods, memberSymbol)
+		} else {
+			symbol.Children = append(symbol.Children, memberSymbol)
+		}
+	}
 }
 
-// createClassSymbol 创建类符号
-func (c *CSharpExtractor) createClassSymbol(node *sitter.Node, content []byte) models.Symbol {
+// createSymbol 创建符号，记录特性和结构化的 XML 文档注释
+func (c *CSharpExtractor) createSymbol(node *sitter.Node, content []byte) models.Symbol {
 	start := node.StartPoint()
 	end := node.EndPoint()
+	summary, doc := parseXMLDocComments(node, content)
+
+	symbol := models.Symbol{
+		Prototype:   c.ExtractPrototype(node, content),
+		Purpose:     summary,
+		Range:       []int{int(start.Row) + 1, int(end.Row) + 1},
+		Doc:         doc,
+		Annotations: c.extractAttributes(node, content),
+	}
+	symbol.Visibility, symbol.Modifiers = c.extractModifiers(node, content)
+	symbol.TypeParams = c.extractTypeParams(node, content)
+	symbol.Params = c.extractParams(node, content)
+	symbol.Returns = c.extractReturns(node, content)
+	return symbol
+}
 
-	prototype := c.extractClassPrototype(node, content)
+// extractParams 解析参数列表（记录的主构造函数参数没有字段名）。ref、out、in、this 修饰写入类型，
+// params 参数标记为可变参数
+func (c *CSharpExtractor) extractParams(node *sitter.Node, content []byte) []models.Param {
+	list := node.ChildByFieldName("parameters")
+	if list == nil {
+		list = c.childOfType(node, "parameter_list")
+	}
+	if list == nil {
+		return nil
+	}
 
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   extractXMLDocComments(node, content),
-		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
-		Methods:   c.extractClassMethods(node, content),
+	var params []models.Param
+	for i := 0; i < int(list.ChildCount()); i++ {
+		child := list.Child(i)
+		switch {
+		case child.Type() == "parameter":
+			params = append(params, c.parameter(child, content))
+		case child.Type() == "params":
+			// params 参数的类型和名称直接挂在参数列表下
+			params = append(params, models.Param{Variadic: true})
+		case len(params) > 0 && params[len(params)-1].Variadic && list.FieldNameForChild(i) == "type":
+			params[len(params)-1].Type = nodeText(child, content)
+		case len(params) > 0 && params[len(params)-1].Variadic && list.FieldNameForChild(i) == "name":
+			params[len(params)-1].Name = nodeText(child, content)
+		}
 	}
+	return params
 }
 
-// extractClassMethods 提取类内部的方法
-func (c *CSharpExtractor) extractClassMethods(classNode *sitter.Node, content []byte) []models.Symbol {
-	var methods []models.Symbol
-
-	childCount := int(classNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := classNode.Child(i)
-		if child == nil {
-			continue
+// parameter 解析单个参数，= 之后的表达式为默认值
+func (c *CSharpExtractor) parameter(node *sitter.Node, content []byte) models.Param {
+	param := models.Param{Name: fieldText(node, "name", content)}
+	var prefix []string
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch {
+		case child.Type() == "modifier":
+			prefix = append(prefix, child.Content(content))
+		case node.FieldNameForChild(i) == "type":
+			param.Type = strings.Join(append(prefix, nodeText(child, content)), " ")
+		case child.Type() == "=" && i+1 < int(node.ChildCount()):
+			param.Default = nodeText(node.Child(i+1), content)
 		}
+	}
+	return param
+}
 
-		childType := child.Type()
-		// 查找类体
-		if childType == "class_body" {
-			// 遍历类体中的方法
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
+// extractTypeParams 解析类型参数，where 子句中的约束以逗号连接作为 Constraint
+func (c *CSharpExtractor) extractTypeParams(node *sitter.Node, content []byte) []models.TypeParam {
+	list := node.ChildByFieldName("type_parameters")
+	if list == nil {
+		list = c.childOfType(node, "type_parameter_list")
+	}
+	if list == nil {
+		return nil
+	}
 
-				bodyChildType := bodyChild.Type()
-				if bodyChildType == "method_declaration" || bodyChildType == "constructor_declaration" {
-					method := c.createMethodSymbol(bodyChild, content)
-					methods = append(methods, method)
-				}
+	var typeParams []models.TypeParam
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		typeParams = append(typeParams, models.TypeParam{Name: fieldText(list.NamedChild(i), "name", content)})
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		clause := node.NamedChild(i)
+		if clause.Type() != "type_parameter_constraints_clause" || clause.NamedChildCount() == 0 {
+			continue
+		}
+		var constraints []string
+		for k := 1; k < int(clause.NamedChildCount()); k++ {
+			constraints = append(constraints, nodeText(clause.NamedChild(k), content))
+		}
+		for k := range typeParams {
+			if typeParams[k].Name == nodeText(clause.NamedChild(0), content) {
+				typeParams[k].Constraint = strings.Join(constraints, ", ")
 			}
 		}
 	}
-
-	return methods
+	return typeParams
 }
 
-// IsClassNode 检查是否是类节点或命名空间节点
-func (c *CSharpExtractor) IsClassNode(nodeType string) bool {
-	return nodeType == "class_declaration" ||
-		nodeType == "interface_declaration" ||
-		nodeType == "enum_declaration" ||
-		nodeType == "namespace_declaration"
+// extractReturns 提取方法、委托、运算符和索引器的返回类型，void 视为没有返回值
+func (c *CSharpExtractor) extractReturns(node *sitter.Node, content []byte) []string {
+	var returnType string
+	switch node.Type() {
+	case "method_declaration":
+		returnType = fieldText(node, "returns", content)
+	case "delegate_declaration", "operator_declaration", "conversion_operator_declaration", "indexer_declaration":
+		returnType = fieldText(node, "type", content)
+	}
+	if returnType == "" || returnType == "void" {
+		return nil
+	}
+	return []string{returnType}
 }
 
-// IsFunctionBodyNode 检查是否是函数体节点
-func (c *CSharpExtractor) IsFunctionBodyNode(nodeType string) bool {
-	return nodeType == "block" || nodeType == "block_statement"
+// childOfType 返回第一个指定类型的直接子节点
+func (c *CSharpExtractor) childOfType(node *sitter.Node, nodeType string) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == nodeType {
+			return child
+		}
+	}
+	return nil
 }
 
-// IsInsideClass 检查节点是否在类内部
-func (c *CSharpExtractor) IsInsideClass(node *sitter.Node) bool {
-	current := node.Parent()
-	for current != nil {
-		nodeType := current.Type()
-		if nodeType == "class_declaration" ||
-			nodeType == "interface_declaration" ||
-			nodeType == "enum_declaration" ||
-			nodeType == "namespace_declaration" {
-			return true
+// extractModifiers 提取访问修饰符和其他修饰符，没有访问修饰符时可见性为空，由调用方按声明位置补充默认值。
+// protected internal 和 private protected 都记录为 protected
+func (c *CSharpExtractor) extractModifiers(node *sitter.Node, content []byte) (string, []string) {
+	var access []string
+	var modifiers []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "modifier" {
+			continue
+		}
+		switch word := child.Content(content); word {
+		case "public", "protected", "internal", "private":
+			access = append(access, word)
+		default:
+			if csharpModifierKeywords[word] {
+				modifiers = appendModifier(modifiers, word)
+			}
 		}
-		current = current.Parent()
 	}
-	return false
+
+	switch {
+	case len(access) == 0:
+		return "", modifiers
+	case len(access) > 1:
+		return visibilityProtected, modifiers
+	default:
+		return access[0], modifiers
+	}
 }
 
-// ExtractComments 提取C#注释
-func (c *CSharpExtractor) ExtractComments(node *sitter.Node, content []byte) string {
-	return extractXMLDocComments(node, content)
+// extractAttributes 提取声明上的特性，每个特性单独记录，如 [HttpGet("{id}")]
+func (c *CSharpExtractor) extractAttributes(node *sitter.Node, content []byte) []string {
+	var attributes []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		list := node.NamedChild(i)
+		if list.Type() != "attribute_list" {
+			continue
+		}
+		for k := 0; k < int(list.NamedChildCount()); k++ {
+			if attribute := list.NamedChild(k); attribute.Type() == "attribute" {
+				attributes = append(attributes, "["+c.cleanText(attribute.Content(content))+"]")
+			}
+		}
+	}
+	return attributes
 }
 
-// extractClassPrototype 提取C#类原型
-func (c *CSharpExtractor) extractClassPrototype(node *sitter.Node, content []byte) string {
-	childCount := int(node.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := node.Child(i)
-		if child == nil {
+// extractAccessors 把访问器列表转换为 { get; private set; } 形式，去掉访问器体
+func (c *CSharpExtractor) extractAccessors(accessors *sitter.Node, content []byte) string {
+	var parts []string
+	for i := 0; i < int(accessors.NamedChildCount()); i++ {
+		accessor := accessors.NamedChild(i)
+		if accessor.Type() != "accessor_declaration" {
 			continue
 		}
+		end := accessor.EndByte()
+		if body := accessor.ChildByFieldName("body"); body != nil {
+			end = body.StartByte()
+		}
+		parts = append(parts, strings.TrimSuffix(c.cleanText(string(content[accessor.StartByte():end])), ";")+";")
+	}
+	if len(parts) == 0 {
+		return "{ }"
+	}
+	return "{ " + strings.Join(parts, " ") + " }"
+}
 
-		childType := child.Type()
-		// 查找类体开始位置
-		if childType == "class_body" || childType == "declaration_list" || childType == "{" {
-			prototype := string(content[node.StartByte():child.StartByte()])
-			return strings.TrimSpace(prototype)
+// hasModifier 检查声明是否带有指定的修饰符（如 partial、static）
+func (c *CSharpExtractor) hasModifier(node *sitter.Node, modifier string, content []byte) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "modifier" && child.Content(content) == modifier {
+			return true
 		}
 	}
-	return ""
+	return false
+}
+
+// namespaceScope 返回命名空间内部的作用域，限定名称按 . 拆分
+func (c *CSharpExtractor) namespaceScope(node *sitter.Node, scope []string, content []byte) []string {
+	name := node.ChildByFieldName("name")
+	if name == nil {
+		return scope
+	}
+	innerScope := scope
+	for _, part := range strings.Split(name.Content(content), ".") {
+		innerScope = appendScope(innerScope, strings.TrimSpace(part))
+	}
+	return innerScope
 }
 
 // extractNamespacePrototype 提取C#命名空间原型
@@ -252,27 +510,3 @@ func (c *CSharpExtractor) extractNamespacePrototype(node *sitter.Node, content [
 	}
 	return ""
 }
-
-// extractMethodPrototype 提取C#方法原型
-func (c *CSharpExtractor) extractMethodPrototype(node *sitter.Node, content []byte) string {
-	declarationEnd := c.findFunctionBodyEnd(node, c.IsFunctionBodyNode)
-	if declarationEnd > node.StartByte() {
-		prototype := string(content[node.StartByte():declarationEnd])
-		return c.cleanText(prototype)
-	}
-	return ""
-}
-
-// createMethodSymbol 创建方法符号
-func (c *CSharpExtractor) createMethodSymbol(node *sitter.Node, content []byte) models.Symbol {
-	start := node.StartPoint()
-	end := node.EndPoint()
-
-	prototype := c.extractMethodPrototype(node, content)
-
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   extractXMLDocComments(node, content),
-		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
-	}
-}
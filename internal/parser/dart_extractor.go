@@ -0,0 +1,482 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// dartSyntax Dart 的注释和字符串语法
+	dartSyntax = textSyntax{
+		lineComments:  []string{"//"},
+		blockComments: [][2]string{{"/*", "*/"}},
+		quotes:        `'"`,
+		tripleQuotes:  true,
+	}
+
+	// dartTypeDeclRegex 匹配类、mixin、扩展和枚举声明
+	dartTypeDeclRegex = regexp.MustCompile(`^(?:(?:abstract|base|interface|final|sealed|mixin)\s+)*(class|mixin|extension|enum)\b\s*(?:type\s+)?(\w*)`)
+
+	// dartDirectiveRegex 匹配 import/export/part/library 指令
+	dartDirectiveRegex = regexp.MustCompile(`^(import|export|part|library)\b`)
+
+	// dartGetterRegex 匹配 getter 声明
+	dartGetterRegex = regexp.MustCompile(`\bget\s+\w+\s*$`)
+
+	// dartBodyPrefixRegex 匹配函数体之前的修饰（async、async*、sync*）
+	dartBodyPrefixRegex = regexp.MustCompile(`(\)|\basync\*?|\bsync\*)\s*$`)
+
+	// dartAnnotationRegex 匹配注解名称（如 @override、@JsonKey）
+	dartAnnotationRegex = regexp.MustCompile(`^@[\w.]+\s*`)
+
+	// dartWidgetRegex 匹配 Flutter 组件基类
+	dartWidgetRegex = regexp.MustCompile(`\bextends\s+(StatelessWidget|StatefulWidget)\b`)
+
+	// dartNameRegex 匹配函数、构造函数或 typedef 的名称（参数列表或 = 之前的标识符）
+	dartNameRegex = regexp.MustCompile(`(\w+)\s*(?:<[^<>]*>)?\s*[(=]`)
+
+	// dartAsyncRegex 匹配函数体之前的 async/async*
+	dartAsyncRegex = regexp.MustCompile(`\basync\*?\s*$`)
+)
+
+// dartModifierKeywords 声明开头记录为修饰符的关键字
+var dartModifierKeywords = map[string]bool{
+	"static": true, "abstract": true, "final": true, "const": true, "factory": true, "external": true,
+	"late": true, "base": true, "interface": true, "sealed": true,
+}
+
+// DartExtractor Dart/Flutter 提取器（基于文本，没有可用的 Tree-sitter 语法）
+type DartExtractor struct {
+	BaseExtractor
+}
+
+// NewDartExtractor 创建Dart提取器
+func NewDartExtractor() *DartExtractor {
+	return &DartExtractor{}
+}
+
+// dartDeclaration 拆分出的一个声明
+type dartDeclaration struct {
+	start     int    // 声明开始位置（注解之后）
+	end       int    // 声明结束位置（不含）
+	startLine int    // 声明所在行（包含注解）
+	bodyStart int    // { 或 => 的位置，没有函数体/类体时为 -1
+	head      string // 屏蔽后的声明头部
+}
+
+// ExtractSymbols 提取类、mixin、扩展、枚举、typedef 和顶层函数
+func (d *DartExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	text := string(content)
+	masked := maskSource(text, dartSyntax)
+	lines := strings.Split(text, "\n")
+
+	var symbols []models.Symbol
+	for _, decl := range d.splitDeclarations(masked, 0, len(masked)) {
+		switch {
+		case dartDirectiveRegex.MatchString(decl.head):
+			continue
+		case dartTypeDeclRegex.MatchString(decl.head):
+			symbols = append(symbols, d.createTypeSymbol(text, masked, lines, decl))
+		case strings.HasPrefix(decl.head, "typedef"):
+			symbols = append(symbols, d.createSymbol(text, masked, lines, decl))
+		case d.isFunction(decl.head):
+			symbols = append(symbols, d.createSymbol(text, masked, lines, decl))
+		}
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的 /// 或 // 注释块作为文件用途
+func (d *DartExtractor) ExtractFilePurpose(content []byte) string {
+	var commentLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "//") {
+			if trimmed == "" && len(commentLines) == 0 {
+				continue
+			}
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimLeft(trimmed, "/"))
+		// 跳过分析器指令
+		if comment != "" && !strings.HasPrefix(comment, "ignore_for_file:") && !strings.HasPrefix(comment, "coverage:") {
+			commentLines = append(commentLines, comment)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// splitDeclarations 将 [start, end) 区间拆分为声明，声明以顶层的 ; 或函数体/类体的 } 结束
+func (d *DartExtractor) splitDeclarations(masked string, start, end int) []dartDeclaration {
+	var declarations []dartDeclaration
+	declStart := start
+	bodyStart := -1
+
+	finish := func(declEnd int) {
+		if decl, ok := d.newDeclaration(masked, declStart, declEnd, bodyStart); ok {
+			declarations = append(declarations, decl)
+		}
+		declStart = declEnd
+		bodyStart = -1
+	}
+
+	for i := start; i < end; i++ {
+		switch masked[i] {
+		case '(', '[':
+			if closeIdx := matchBrace(masked, i); closeIdx > i && closeIdx < end {
+				i = closeIdx
+			}
+		case '=':
+			// 箭头函数体
+			if i+1 < end && masked[i+1] == '>' && bodyStart < 0 {
+				bodyStart = i
+			}
+		case '{':
+			closeIdx := matchBrace(masked, i)
+			if closeIdx < 0 || closeIdx >= end {
+				closeIdx = end - 1
+			}
+			head := masked[declStart:i]
+			if bodyStart < 0 && (dartBodyPrefixRegex.MatchString(head) || dartGetterRegex.MatchString(head) ||
+				dartTypeDeclRegex.MatchString(d.stripAnnotations(masked, declStart, i))) {
+				bodyStart = i
+				i = closeIdx
+				finish(closeIdx + 1)
+				continue
+			}
+			// 集合字面量，继续查找声明结束的 ;
+			i = closeIdx
+		case ';':
+			finish(i + 1)
+		}
+	}
+	finish(end)
+
+	return declarations
+}
+
+// newDeclaration 创建声明，跳过空白和注解
+func (d *DartExtractor) newDeclaration(masked string, start, end, bodyStart int) (dartDeclaration, bool) {
+	first := skipSpace(masked, start)
+	if first >= end {
+		return dartDeclaration{}, false
+	}
+
+	declStart := first
+	for declStart < end && masked[declStart] == '@' {
+		match := dartAnnotationRegex.FindStringIndex(masked[declStart:end])
+		if match == nil {
+			break
+		}
+		declStart += match[1]
+		if declStart < end && masked[declStart] == '(' {
+			if closeIdx := matchBrace(masked, declStart); closeIdx > 0 && closeIdx < end {
+				declStart = closeIdx + 1
+			}
+		}
+		declStart = skipSpace(masked, declStart)
+	}
+
+	headEnd := end
+	if bodyStart >= 0 {
+		headEnd = bodyStart
+	}
+	head := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(masked[declStart:minInt(headEnd, end)]), ";"))
+	if head == "" {
+		return dartDeclaration{}, false
+	}
+
+	return dartDeclaration{
+		start:     declStart,
+		end:       end,
+		startLine: lineOfOffset(masked, first),
+		bodyStart: bodyStart,
+		head:      head,
+	}, true
+}
+
+// stripAnnotations 返回去除开头注解后的声明头部
+func (d *DartExtractor) stripAnnotations(masked string, start, end int) string {
+	if decl, ok := d.newDeclaration(masked, start, end, -1); ok {
+		return decl.head
+	}
+	return ""
+}
+
+// isFunction 检查声明是否为函数、方法、构造函数或 getter/setter（而不是变量或字段）
+func (d *DartExtractor) isFunction(head string) bool {
+	paren := strings.IndexByte(head, '(')
+	if paren < 0 {
+		return dartGetterRegex.MatchString(head)
+	}
+
+	// 赋值号出现在参数列表之前的是变量（如 final f = foo();）
+	for i := 0; i < paren; i++ {
+		if head[i] != '=' {
+			continue
+		}
+		prev, next := byte(' '), byte(' ')
+		if i > 0 {
+			prev = head[i-1]
+		}
+		if i+1 < len(head) {
+			next = head[i+1]
+		}
+		if strings.IndexByte("=!<>", prev) < 0 && next != '=' && next != '>' {
+			return false
+		}
+	}
+	return true
+}
+
+// createSymbol 创建函数、方法或 typedef 符号，以 _ 开头的名称为库私有
+func (d *DartExtractor) createSymbol(text, masked string, lines []string, decl dartDeclaration) models.Symbol {
+	symbol := models.Symbol{
+		Prototype:  d.prototype(text, masked, decl),
+		Purpose:    docCommentAbove(lines, decl.startLine, "///"),
+		Range:      []int{decl.startLine, lineOfOffset(masked, decl.end-1)},
+		Visibility: underscoreVisibility(d.declarationName(decl.head)),
+		Modifiers:  d.extractModifiers(decl.head),
+	}
+	if !strings.HasPrefix(decl.head, "typedef") {
+		symbol.TypeParams, symbol.Params, symbol.Returns = d.extractSignature(text, masked, decl)
+	}
+	return symbol
+}
+
+// extractSignature 解析声明头部的类型参数、参数列表和返回类型。类型声明只有类型参数；
+// 构造函数、setter 和 void 函数没有返回类型
+func (d *DartExtractor) extractSignature(text, masked string, decl dartDeclaration) ([]models.TypeParam, []models.Param, []string) {
+	headEnd := decl.end
+	if decl.bodyStart >= 0 {
+		headEnd = decl.bodyStart
+	}
+
+	if match := dartTypeDeclRegex.FindStringSubmatchIndex(masked[decl.start:headEnd]); match != nil {
+		if next := decl.start + match[5]; next < headEnd && masked[next] == '<' {
+			if closeIdx := matchAngle(masked, next); closeIdx > next {
+				return d.typeParams(text[next+1 : closeIdx]), nil, nil
+			}
+		}
+		return nil, nil, nil
+	}
+
+	paren := strings.IndexByte(masked[decl.start:headEnd], '(')
+	if paren < 0 {
+		// getter：get 之前为返回类型
+		return nil, nil, d.returns(text[decl.start:headEnd], "get")
+	}
+	paren += decl.start
+	closeIdx := matchBrace(masked, paren)
+	if closeIdx < 0 || closeIdx > headEnd {
+		return nil, nil, nil
+	}
+
+	var typeParams []models.TypeParam
+	before := strings.TrimSpace(text[decl.start:paren])
+	if strings.HasSuffix(before, ">") {
+		// 名称之后的 <...>，返回类型中也可能有尖括号
+		for open := 0; open < len(before); open++ {
+			if before[open] == '<' && matchAngle(before, open) == len(before)-1 {
+				typeParams = d.typeParams(before[open+1 : len(before)-1])
+				before = before[:open]
+				break
+			}
+		}
+	}
+	return typeParams, d.params(text, masked, paren+1, closeIdx), d.returns(before, "")
+}
+
+// returns 从名称之前的部分提取返回类型：去掉开头的修饰符，marker（get）或 operator、set 之前为返回类型，
+// 否则去掉最后的名称；命名构造函数（Foo.named）没有返回类型
+func (d *DartExtractor) returns(head, marker string) []string {
+	words := strings.Fields(head)
+	for len(words) > 0 && dartModifierKeywords[words[0]] {
+		words = words[1:]
+	}
+
+	var returnType string
+	for i, word := range words {
+		if word == marker || word == "operator" || word == "set" {
+			returnType = strings.Join(words[:i], " ")
+			if word == "set" {
+				returnType = ""
+			}
+			break
+		}
+		if i == len(words)-1 {
+			name, rest := splitTypedName(strings.Join(words, " "))
+			if name != "" && !strings.HasSuffix(rest, ".") {
+				returnType = rest
+			}
+		}
+	}
+	if returnType == "" || returnType == "void" {
+		return nil
+	}
+	return []string{returnType}
+}
+
+// params 解析 [start, end) 区间的参数列表，{} 中的命名参数和 [] 中的可选位置参数展开为普通参数
+func (d *DartExtractor) params(text, masked string, start, end int) []models.Param {
+	var params []models.Param
+	for _, segment := range splitGenericCommas(masked, start, end) {
+		first := skipSpace(masked, segment[0])
+		if first >= segment[1] {
+			continue
+		}
+		if masked[first] == '{' || masked[first] == '[' {
+			if closeIdx := matchBrace(masked, first); closeIdx > first && closeIdx < segment[1] {
+				params = append(params, d.params(text, masked, first+1, closeIdx)...)
+			}
+			continue
+		}
+		params = append(params, d.param(strings.TrimSpace(text[first:segment[1]])))
+	}
+	return params
+}
+
+// param 解析单个参数：去掉 required、covariant 等修饰，this.x 和 super.x 形式的参数没有显式类型，
+// 函数类型参数（void cb(int x)）的类型写作 void Function(int x)
+func (d *DartExtractor) param(text string) models.Param {
+	declaration, defaultValue := splitDefault(text)
+	words := strings.Fields(declaration)
+	for len(words) > 1 && (words[0] == "required" || words[0] == "covariant" || words[0] == "final" || words[0] == "var") {
+		words = words[1:]
+	}
+	declaration = strings.Join(words, " ")
+
+	if open := strings.IndexByte(declaration, '('); open >= 0 && strings.HasSuffix(declaration, ")") {
+		name, returnType := splitTypedName(declaration[:open])
+		return models.Param{Name: name, Type: strings.TrimSpace(returnType + " Function" + declaration[open:]), Default: defaultValue}
+	}
+
+	name, paramType := splitTypedName(declaration)
+	if paramType == "this." || paramType == "super." {
+		paramType = ""
+	}
+	return models.Param{Name: name, Type: paramType, Default: defaultValue}
+}
+
+// typeParams 解析 <T extends num, U> 中的类型参数
+func (d *DartExtractor) typeParams(text string) []models.TypeParam {
+	var typeParams []models.TypeParam
+	for _, part := range splitTypeList(text) {
+		typeParam := models.TypeParam{Name: part}
+		if fields := strings.SplitN(part, " extends ", 2); len(fields) == 2 {
+			typeParam.Name, typeParam.Constraint = strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1])
+		}
+		typeParams = append(typeParams, typeParam)
+	}
+	return typeParams
+}
+
+// declarationName 返回声明的名称，命名构造函数（如 Foo._internal）返回 . 之后的部分
+func (d *DartExtractor) declarationName(head string) string {
+	if match := dartTypeDeclRegex.FindStringSubmatch(head); match != nil {
+		return match[2]
+	}
+	if dartGetterRegex.MatchString(head) {
+		fields := strings.Fields(head)
+		return fields[len(fields)-1]
+	}
+	if match := dartNameRegex.FindStringSubmatch(strings.TrimPrefix(head, "typedef")); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// extractModifiers 提取声明开头的 static、final、factory 等关键字，以及函数体之前的 async
+func (d *DartExtractor) extractModifiers(head string) []string {
+	var modifiers []string
+	for _, word := range strings.Fields(head) {
+		if !dartModifierKeywords[word] {
+			break
+		}
+		modifiers = appendModifier(modifiers, word)
+	}
+	if dartAsyncRegex.MatchString(head) {
+		modifiers = appendModifier(modifiers, "async")
+	}
+	return modifiers
+}
+
+// createTypeSymbol 创建类、mixin、扩展或枚举符号，成员作为方法，枚举值作为子符号
+func (d *DartExtractor) createTypeSymbol(text, masked string, lines []string, decl dartDeclaration) models.Symbol {
+	symbol := d.createSymbol(text, masked, lines, decl)
+	if dartWidgetRegex.MatchString(decl.head) {
+		symbol.Kind = "widget"
+	}
+	if decl.bodyStart < 0 || masked[decl.bodyStart] != '{' {
+		return symbol
+	}
+
+	bodyStart := decl.bodyStart + 1
+	bodyEnd := decl.end - 1
+
+	// 枚举值位于第一个顶层分号之前
+	if dartTypeDeclRegex.FindStringSubmatch(decl.head)[1] == "enum" {
+		valuesEnd := bodyEnd
+		for _, segment := range d.splitDeclarations(masked, bodyStart, bodyEnd) {
+			if strings.HasSuffix(strings.TrimSpace(masked[segment.start:segment.end]), ";") {
+				valuesEnd = segment.end - 1
+			}
+			break
+		}
+		symbol.Children = d.extractEnumValues(text, masked, lines, bodyStart, valuesEnd)
+		if valuesEnd == bodyEnd {
+			return symbol
+		}
+		bodyStart = valuesEnd + 1
+	}
+
+	for _, member := range d.splitDeclarations(masked, bodyStart, bodyEnd) {
+		if d.isFunction(member.head) {
+			symbol.Methods = append(symbol.Methods, d.createSymbol(text, masked, lines, member))
+		}
+	}
+
+	return symbol
+}
+
+// extractEnumValues 提取枚举值
+func (d *DartExtractor) extractEnumValues(text, masked string, lines []string, start, end int) []models.Symbol {
+	var values []models.Symbol
+	for _, segment := range splitGenericCommas(masked, start, end) {
+		decl, ok := d.newDeclaration(masked, segment[0], segment[1], -1)
+		if !ok {
+			continue
+		}
+		values = append(values, models.Symbol{
+			Prototype:  d.cleanText(text[decl.start:segment[1]]),
+			Purpose:    docCommentAbove(lines, decl.startLine, "///"),
+			Range:      []int{decl.startLine, lineOfOffset(masked, segment[1]-1)},
+			Visibility: visibilityPublic,
+		})
+	}
+	return values
+}
+
+// prototype 提取声明原型：去除函数体、构造函数初始化列表和结尾分号
+func (d *DartExtractor) prototype(text, masked string, decl dartDeclaration) string {
+	end := decl.end
+	if decl.bodyStart >= 0 {
+		end = decl.bodyStart
+	}
+
+	// 构造函数的初始化列表（参数列表之后的 : ...）
+	if paren := strings.IndexByte(masked[decl.start:end], '('); paren >= 0 && !dartTypeDeclRegex.MatchString(decl.head) {
+		if closeIdx := matchBrace(masked, decl.start+paren); closeIdx > 0 && closeIdx < end {
+			if next := skipSpace(masked, closeIdx+1); next < end && masked[next] == ':' {
+				end = closeIdx + 1
+			}
+		}
+	}
+
+	return strings.TrimSuffix(d.cleanText(text[decl.start:end]), ";")
+}
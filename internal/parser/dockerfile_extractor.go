@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var (
+	// dockerfileDirectiveRegex 匹配文件开头的解析器指令，如 # syntax=docker/dockerfile:1
+	dockerfileDirectiveRegex = regexp.MustCompile(`(?i)^#\s*(syntax|escape|check)\s*=`)
+
+	// dockerfileDescriptionRegex 匹配 OCI 镜像描述标签
+	dockerfileDescriptionRegex = regexp.MustCompile(`(?m)^\s*LABEL\s+.*\borg\.opencontainers\.image\.description\s*=\s*"([^"]*)"`)
+)
+
+// dockerfileStageInstructions 作为构建阶段子符号输出的指令
+var dockerfileStageInstructions = map[string]bool{
+	"arg_instruction":         true,
+	"env_instruction":         true,
+	"expose_instruction":      true,
+	"volume_instruction":      true,
+	"user_instruction":        true,
+	"healthcheck_instruction": true,
+	"entrypoint_instruction":  true,
+	"cmd_instruction":         true,
+}
+
+// DockerfileExtractor Dockerfile 提取器
+type DockerfileExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewDockerfileExtractor 创建Dockerfile提取器
+func NewDockerfileExtractor() *DockerfileExtractor {
+	return &DockerfileExtractor{
+		queries: []string{
+			"(source_file (from_instruction) @symbol)",
+		},
+	}
+}
+
+// GetQueries 获取Dockerfile的Tree-sitter查询规则
+func (d *DockerfileExtractor) GetQueries() []string {
+	return d.queries
+}
+
+// ExtractPrototype 提取指令文本（合并续行）
+func (d *DockerfileExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	prototype := strings.ReplaceAll(node.Content(content), "\\\n", " ")
+	return truncateConfigValue(d.cleanText(prototype))
+}
+
+// ExtractMethods Dockerfile没有方法，返回空
+func (d *DockerfileExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	return []models.Symbol{}
+}
+
+// IsClassNode 检查是否是构建阶段（FROM）节点
+func (d *DockerfileExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "from_instruction"
+}
+
+// IsFunctionBodyNode Dockerfile没有函数体
+func (d *DockerfileExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return false
+}
+
+// IsInsideClass Dockerfile的指令都位于顶层
+func (d *DockerfileExtractor) IsInsideClass(node *sitter.Node) bool {
+	return false
+}
+
+// ExtractComments 提取指令上方紧邻的 # 注释（跳过解析器指令）
+func (d *DockerfileExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	var commentLines []string
+	row := node.StartPoint().Row
+	for sibling := node.PrevNamedSibling(); sibling != nil && sibling.Type() == "comment"; sibling = sibling.PrevNamedSibling() {
+		if sibling.EndPoint().Row+1 < row {
+			break
+		}
+		row = sibling.StartPoint().Row
+
+		text := sibling.Content(content)
+		if dockerfileDirectiveRegex.MatchString(text) {
+			break
+		}
+		if comment := strings.TrimSpace(strings.TrimLeft(text, "#")); comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+	return strings.Join(commentLines, " ")
+}
+
+// ExtractSymbols 按 FROM 划分构建阶段，ARG、ENV、EXPOSE、ENTRYPOINT、CMD 等指令作为阶段的子符号；
+// 第一个 FROM 之前的 ARG 作为顶层符号
+func (d *DockerfileExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+	stage := -1
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		node := root.NamedChild(i)
+		if node.Type() == "comment" {
+			continue
+		}
+
+		symbol := models.Symbol{
+			Prototype: d.ExtractPrototype(node, content),
+			Purpose:   d.ExtractComments(node, content),
+			Range:     []int{int(node.StartPoint().Row) + 1, configEndLine(node)},
+		}
+
+		switch {
+		case node.Type() == "from_instruction":
+			stage = len(symbols)
+			symbols = append(symbols, symbol)
+			continue
+		case stage >= 0:
+			symbols[stage].Range[1] = symbol.Range[1]
+			if dockerfileStageInstructions[node.Type()] {
+				symbols[stage].Children = append(symbols[stage].Children, symbol)
+			}
+		case node.Type() == "arg_instruction":
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的注释（跳过解析器指令），否则使用 OCI 镜像描述标签
+func (d *DockerfileExtractor) ExtractFilePurpose(content []byte) string {
+	lines := strings.Split(string(content), "\n")
+	start := 0
+	for start < len(lines) && dockerfileDirectiveRegex.MatchString(strings.TrimSpace(lines[start])) {
+		start++
+	}
+
+	if purpose := leadingHashComment(lines[start:]); purpose != "" {
+		return purpose
+	}
+	if match := dockerfileDescriptionRegex.FindStringSubmatch(string(content)); match != nil {
+		return match[1]
+	}
+	return ""
+}
@@ -0,0 +1,427 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// elixirModuleCalls 定义模块、协议和协议实现的宏
+var elixirModuleCalls = map[string]bool{
+	"defmodule":   true,
+	"defprotocol": true,
+	"defimpl":     true,
+}
+
+// elixirFunctionCalls 定义函数和宏的宏
+var elixirFunctionCalls = map[string]bool{
+	"def":         true,
+	"defp":        true,
+	"defmacro":    true,
+	"defmacrop":   true,
+	"defguard":    true,
+	"defguardp":   true,
+	"defdelegate": true,
+}
+
+// ElixirExtractor Elixir语言提取器
+type ElixirExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewElixirExtractor 创建Elixir语言提取器
+func NewElixirExtractor() *ElixirExtractor {
+	return &ElixirExtractor{
+		queries: []string{
+			"(source (call target: (identifier)) @symbol)",
+		},
+	}
+}
+
+// GetQueries 获取Elixir语言的Tree-sitter查询规则
+func (e *ElixirExtractor) GetQueries() []string {
+	return e.queries
+}
+
+// ExtractPrototype 提取模块或函数头（不包含 do 块和守卫之后的函数体）
+func (e *ElixirExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	target := e.callTarget(node, content)
+	if elixirFunctionCalls[target] {
+		if head := e.functionHead(node); head != nil {
+			return target + " " + e.extractFullNode(head, content)
+		}
+	}
+
+	end := node.EndByte()
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "do_block" {
+			end = child.StartByte()
+		}
+	}
+	return e.cleanText(string(content[node.StartByte():end]))
+}
+
+// ExtractMethods 提取模块中的函数和宏，同名同元数的多个子句合并为一个符号
+func (e *ElixirExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	_, methods := e.extractBlock(classNode, e.moduleName(classNode, "", content), content)
+	return methods
+}
+
+// IsClassNode 检查是否是模块定义节点
+func (e *ElixirExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "call"
+}
+
+// IsFunctionBodyNode 检查是否是 do 块
+func (e *ElixirExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "do_block"
+}
+
+// IsInsideClass 检查节点是否在模块内部
+func (e *ElixirExtractor) IsInsideClass(node *sitter.Node) bool {
+	return node.Parent() != nil && node.Parent().Type() == "do_block"
+}
+
+// ExtractComments 提取模块的 @moduledoc 或函数前的 @doc
+func (e *ElixirExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	if elixirModuleCalls[e.callTarget(node, content)] {
+		if block := e.doBlock(node); block != nil {
+			for i := 0; i < int(block.NamedChildCount()); i++ {
+				if name, value := e.attribute(block.NamedChild(i), content); name == "moduledoc" {
+					return e.docText(value, content)
+				}
+			}
+		}
+		return ""
+	}
+
+	for sibling := node.PrevNamedSibling(); sibling != nil; sibling = sibling.PrevNamedSibling() {
+		name, value := e.attribute(sibling, content)
+		switch name {
+		case "doc":
+			return e.docText(value, content)
+		case "spec", "impl", "decorate":
+			continue
+		}
+		break
+	}
+	return ""
+}
+
+// ExtractSymbols 提取顶层模块，嵌套模块和 @behaviour 作为子符号，函数作为方法
+func (e *ElixirExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	modules, _ := e.extractBlock(root, "", content)
+	return modules
+}
+
+// extractBlock 提取源文件或模块 do 块中的模块定义和函数，module 为所在模块的完整名称，
+// 函数的 qualifiedName 记录 Module.name/arity，带默认值参数的函数记录元数范围
+func (e *ElixirExtractor) extractBlock(node *sitter.Node, module string, content []byte) (modules []models.Symbol, functions []models.Symbol) {
+	block := node
+	if node.Type() == "call" {
+		if block = e.doBlock(node); block == nil {
+			return nil, nil
+		}
+	}
+
+	functionIndex := make(map[string]int)
+	specs := e.collectSpecs(block, content)
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+		if child.Type() != "call" {
+			continue
+		}
+
+		target := e.callTarget(child, content)
+		switch {
+		case elixirModuleCalls[target]:
+			modules = append(modules, e.createModuleSymbol(child, module, content))
+
+		case elixirFunctionCalls[target]:
+			// defp、defmacrop、defguardp 定义的是模块私有函数
+			visibility := visibilityPublic
+			if strings.HasSuffix(target, "p") {
+				visibility = visibilityPrivate
+			}
+			symbol := models.Symbol{
+				Prototype:  e.ExtractPrototype(child, content),
+				Purpose:    e.ExtractComments(child, content),
+				Range:      []int{int(child.StartPoint().Row) + 1, int(child.EndPoint().Row) + 1},
+				Visibility: visibility,
+			}
+
+			// 同名同元数的函数子句合并
+			functionKey := e.functionKey(child, content)
+			key := target + " " + functionKey
+			if index, ok := functionIndex[key]; ok {
+				functions[index].Range = mergeRange(functions[index].Range, symbol.Range)
+				if functions[index].Purpose == "" {
+					functions[index].Purpose = symbol.Purpose
+				}
+				continue
+			}
+			symbol.Params, symbol.Returns = e.extractSignature(child, specs[functionKey], content)
+			symbol.QualifiedName = e.arityRange(functionKey, symbol.Params)
+			if module != "" {
+				symbol.QualifiedName = module + "." + symbol.QualifiedName
+			}
+			functionIndex[key] = len(functions)
+			functions = append(functions, symbol)
+		}
+	}
+
+	return modules, functions
+}
+
+// createModuleSymbol 创建模块符号，包含函数、嵌套模块和实现的 behaviour，parent 为外层模块的完整名称
+func (e *ElixirExtractor) createModuleSymbol(node *sitter.Node, parent string, content []byte) models.Symbol {
+	modules, functions := e.extractBlock(node, e.moduleName(node, parent, content), content)
+
+	var behaviours []models.Symbol
+	if block := e.doBlock(node); block != nil {
+		for i := 0; i < int(block.NamedChildCount()); i++ {
+			child := block.NamedChild(i)
+			if name, value := e.attribute(child, content); name == "behaviour" && value != nil {
+				behaviours = append(behaviours, models.Symbol{
+					Prototype: "@behaviour " + value.Content(content),
+					Range:     []int{int(child.StartPoint().Row) + 1, int(child.EndPoint().Row) + 1},
+				})
+			}
+		}
+	}
+
+	return models.Symbol{
+		Prototype:  e.ExtractPrototype(node, content),
+		Purpose:    e.ExtractComments(node, content),
+		Range:      []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+		Visibility: visibilityPublic,
+		Methods:    functions,
+		Children:   append(behaviours, modules...),
+	}
+}
+
+// moduleName 返回模块的完整名称：嵌套模块拼接外层模块名，协议实现为 协议.类型（省略 for: 时为外层模块）
+func (e *ElixirExtractor) moduleName(node *sitter.Node, parent string, content []byte) string {
+	arguments := e.callArguments(node)
+	if len(arguments) == 0 || arguments[0].Type() != "alias" {
+		return parent
+	}
+	name := nodeText(arguments[0], content)
+
+	if e.callTarget(node, content) == "defimpl" {
+		implFor := parent
+		for _, argument := range arguments[1:] {
+			if argument.Type() != "keywords" {
+				continue
+			}
+			for i := 0; i < int(argument.NamedChildCount()); i++ {
+				pair := argument.NamedChild(i)
+				if key := strings.TrimSpace(fieldText(pair, "key", content)); key == "for:" {
+					implFor = fieldText(pair, "value", content)
+				}
+			}
+		}
+		if implFor != "" {
+			return name + "." + implFor
+		}
+		return name
+	}
+
+	if parent != "" {
+		return parent + "." + name
+	}
+	return name
+}
+
+// collectSpecs 收集 do 块中的 @spec 类型规格，键为 名称/元数，值为 name(types) :: return 形式的类型表达式
+func (e *ElixirExtractor) collectSpecs(block *sitter.Node, content []byte) map[string]*sitter.Node {
+	specs := make(map[string]*sitter.Node)
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		name, spec := e.attribute(block.NamedChild(i), content)
+		if name != "spec" || spec == nil || spec.Type() != "binary_operator" {
+			continue
+		}
+		// @spec f(t) :: t when t: var 的 when 约束不属于签名
+		if operator := spec.ChildByFieldName("operator"); operator != nil && operator.Content(content) == "when" {
+			spec = spec.ChildByFieldName("left")
+		}
+		if spec == nil || spec.Type() != "binary_operator" {
+			continue
+		}
+		if left := spec.ChildByFieldName("left"); left != nil && left.Type() == "call" {
+			specs[fmt.Sprintf("%s/%d", e.callTarget(left, content), len(e.callArguments(left)))] = spec
+		}
+	}
+	return specs
+}
+
+// extractSignature 从函数头解析参数（arg \\ default 的默认值单独记录，模式匹配参数保留原文），
+// 有对应的 @spec 时按位置补充参数类型和返回类型
+func (e *ElixirExtractor) extractSignature(node, spec *sitter.Node, content []byte) ([]models.Param, []string) {
+	head := e.functionHead(node)
+	if head == nil || head.Type() != "call" {
+		return nil, nil
+	}
+
+	var params []models.Param
+	for _, argument := range e.callArguments(head) {
+		param := models.Param{Name: nodeText(argument, content)}
+		if operator := argument.ChildByFieldName("operator"); argument.Type() == "binary_operator" && operator != nil && operator.Content(content) == "\\\\" {
+			param.Name = fieldText(argument, "left", content)
+			param.Default = fieldText(argument, "right", content)
+		}
+		params = append(params, param)
+	}
+	if spec == nil {
+		return params, nil
+	}
+
+	types := e.callArguments(spec.ChildByFieldName("left"))
+	for i := range params {
+		if i < len(types) {
+			params[i].Type = nodeText(types[i], content)
+		}
+	}
+	return params, []string{fieldText(spec, "right", content)}
+}
+
+// callArguments 返回调用的参数节点
+func (e *ElixirExtractor) callArguments(call *sitter.Node) []*sitter.Node {
+	var arguments []*sitter.Node
+	for i := 0; i < int(call.NamedChildCount()); i++ {
+		if list := call.NamedChild(i); list.Type() == "arguments" {
+			for k := 0; k < int(list.NamedChildCount()); k++ {
+				arguments = append(arguments, list.NamedChild(k))
+			}
+		}
+	}
+	return arguments
+}
+
+// callTarget 获取调用的目标名称（如 defmodule、def）
+func (e *ElixirExtractor) callTarget(node *sitter.Node, content []byte) string {
+	if node.Type() != "call" {
+		return ""
+	}
+	if target := node.ChildByFieldName("target"); target != nil && target.Type() == "identifier" {
+		return target.Content(content)
+	}
+	return ""
+}
+
+// doBlock 获取调用的 do 块
+func (e *ElixirExtractor) doBlock(node *sitter.Node) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "do_block" {
+			return child
+		}
+	}
+	return nil
+}
+
+// functionHead 获取函数头（去除 when 守卫），如 get(id)
+func (e *ElixirExtractor) functionHead(node *sitter.Node) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		arguments := node.NamedChild(i)
+		if arguments.Type() != "arguments" || arguments.NamedChildCount() == 0 {
+			continue
+		}
+
+		head := arguments.NamedChild(0)
+		if head.Type() == "binary_operator" {
+			if left := head.ChildByFieldName("left"); left != nil {
+				head = left
+			}
+		}
+		return head
+	}
+	return nil
+}
+
+// functionKey 生成函数的 名称/元数 标识
+func (e *ElixirExtractor) functionKey(node *sitter.Node, content []byte) string {
+	head := e.functionHead(node)
+	if head == nil {
+		return ""
+	}
+	if head.Type() != "call" {
+		return head.Content(content) + "/0"
+	}
+
+	name := ""
+	if target := head.ChildByFieldName("target"); target != nil {
+		name = target.Content(content)
+	}
+	arity := 0
+	for i := 0; i < int(head.NamedChildCount()); i++ {
+		if arguments := head.NamedChild(i); arguments.Type() == "arguments" {
+			arity = int(arguments.NamedChildCount())
+		}
+	}
+	return fmt.Sprintf("%s/%d", name, arity)
+}
+
+// arityRange 带默认值参数（arg \\ default）的函数同时定义了多个元数，
+// 如 list(a, b \\ 1) 对应 list/1 和 list/2，记为 list/1-2；没有默认值时返回原标识
+func (e *ElixirExtractor) arityRange(functionKey string, params []models.Param) string {
+	defaults := 0
+	for _, param := range params {
+		if param.Default != "" {
+			defaults++
+		}
+	}
+	if defaults == 0 {
+		return functionKey
+	}
+	name := functionKey[:strings.LastIndex(functionKey, "/")]
+	return fmt.Sprintf("%s/%d-%d", name, len(params)-defaults, len(params))
+}
+
+// attribute 解析模块属性（如 @doc "..."），返回属性名和值节点
+func (e *ElixirExtractor) attribute(node *sitter.Node, content []byte) (string, *sitter.Node) {
+	if node == nil || node.Type() != "unary_operator" || !strings.HasPrefix(node.Content(content), "@") {
+		return "", nil
+	}
+
+	operand := node.ChildByFieldName("operand")
+	if operand == nil {
+		return "", nil
+	}
+	if operand.Type() == "identifier" {
+		return operand.Content(content), nil
+	}
+
+	name := e.callTarget(operand, content)
+	for i := 0; i < int(operand.NamedChildCount()); i++ {
+		if arguments := operand.NamedChild(i); arguments.Type() == "arguments" && arguments.NamedChildCount() > 0 {
+			return name, arguments.NamedChild(0)
+		}
+	}
+	return name, nil
+}
+
+// docText 提取 @doc/@moduledoc 字符串的第一段（@doc false 返回空）
+func (e *ElixirExtractor) docText(value *sitter.Node, content []byte) string {
+	if value == nil {
+		return ""
+	}
+
+	var text string
+	switch value.Type() {
+	case "string", "sigil":
+		for i := 0; i < int(value.NamedChildCount()); i++ {
+			if child := value.NamedChild(i); child.Type() == "quoted_content" {
+				text = child.Content(content)
+				break
+			}
+		}
+	default:
+		return ""
+	}
+
+	paragraph, _, _ := strings.Cut(strings.TrimSpace(text), "\n\n")
+	return e.cleanText(paragraph)
+}
@@ -0,0 +1,403 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// erlangSyntax Erlang 的注释和字符串语法（带引号的原子同样屏蔽）
+	erlangSyntax = textSyntax{
+		lineComments: []string{"%"},
+		quotes:       `"'`,
+	}
+
+	// erlangAttributeRegex 匹配模块属性，如 -module(、-export(
+	erlangAttributeRegex = regexp.MustCompile(`^-\s*([a-z_]\w*)`)
+
+	// erlangFunctionRegex 匹配函数子句头部的函数名
+	erlangFunctionRegex = regexp.MustCompile(`^([a-z]\w*|'[^']*')\s*\(`)
+
+	// erlangExportItemRegex 匹配导出列表中的 name/arity
+	erlangExportItemRegex = regexp.MustCompile(`([a-z]\w*|'[^']*')\s*/\s*(\d+)`)
+
+	// erlangExportAllRegex 匹配导出全部函数的编译选项
+	erlangExportAllRegex = regexp.MustCompile(`^-\s*compile\s*\(.*\bexport_all\b`)
+
+	// erlangModuleNameRegex 匹配 -module 属性中的模块名
+	erlangModuleNameRegex = regexp.MustCompile(`^-\s*module\s*\(\s*([a-z]\w*|'[^']*')`)
+)
+
+// erlangOutlineAttributes 作为符号输出的模块属性
+var erlangOutlineAttributes = map[string]bool{
+	"behaviour": true,
+	"behavior":  true,
+	"record":    true,
+	"type":      true,
+	"opaque":    true,
+	"callback":  true,
+	"define":    true,
+}
+
+// erlangSpec -spec 属性中声明的参数和返回类型
+type erlangSpec struct {
+	params  []models.Param
+	returns []string
+}
+
+// ErlangExtractor Erlang提取器（基于文本，没有可用的 Tree-sitter 语法）
+type ErlangExtractor struct {
+	BaseExtractor
+}
+
+// NewErlangExtractor 创建Erlang提取器
+func NewErlangExtractor() *ErlangExtractor {
+	return &ErlangExtractor{}
+}
+
+// ExtractSymbols 提取 -module（导出列表作为子符号）、behaviour、record、type、宏定义和函数，
+// 同一函数（名称/元数）的所有子句位于同一个以 . 结尾的形式中，合并为一个符号，
+// qualifiedName 记录 module:name/arity。
+// 函数在导出列表中（或使用 -compile(export_all)）时为 exported，否则为 private
+func (e *ErlangExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	text := string(content)
+	masked := maskSource(text, erlangSyntax)
+	lines := strings.Split(text, "\n")
+
+	var symbols []models.Symbol
+	moduleIndex := -1
+	moduleName := ""
+	var exports []models.Symbol
+	exportAll := false
+	functionKeys := make(map[int]string) // 函数符号位置到 name/arity 的映射
+	docLine := 0                         // -spec/-doc 之前注释所在的行，用于函数说明
+	specs := make(map[string]erlangSpec) // name/arity 到 -spec 中参数和返回类型的映射
+
+	for _, form := range e.splitForms(masked) {
+		start := skipSpace(masked, form[0])
+		head := strings.TrimSpace(masked[start:form[1]])
+		startLine := lineOfOffset(masked, start)
+		endLine := lineOfOffset(masked, form[1]-1)
+		source := e.cleanText(strings.TrimSuffix(text[start:form[1]], "."))
+
+		if match := erlangAttributeRegex.FindStringSubmatch(head); match != nil {
+			switch name := match[1]; {
+			case name == "module":
+				moduleIndex = len(symbols)
+				if match := erlangModuleNameRegex.FindStringSubmatch(text[start:form[1]]); match != nil {
+					moduleName = match[1]
+				}
+				symbols = append(symbols, models.Symbol{
+					Prototype: source,
+					Purpose:   e.commentAbove(lines, startLine),
+					Range:     []int{startLine, endLine},
+				})
+			case name == "export":
+				// 在屏蔽后的文本中匹配，从原文中取名称（带引号的原子在屏蔽后的文本中被替换）
+				for _, item := range erlangExportItemRegex.FindAllStringSubmatchIndex(head, -1) {
+					exports = append(exports, models.Symbol{
+						Prototype: text[start+item[2]:start+item[3]] + "/" + head[item[4]:item[5]],
+						Range:     []int{startLine, endLine},
+					})
+				}
+			case name == "compile":
+				exportAll = exportAll || erlangExportAllRegex.MatchString(head)
+			case name == "spec" || name == "doc":
+				if name == "spec" {
+					if key, spec, ok := e.parseSpec(text, masked, start, form[1]); ok {
+						specs[key] = spec
+					}
+				}
+				if docLine == 0 {
+					docLine = startLine
+				}
+				continue
+			case erlangOutlineAttributes[name]:
+				symbols = append(symbols, models.Symbol{
+					Prototype: source,
+					Purpose:   e.commentAbove(lines, startLine),
+					Range:     []int{startLine, endLine},
+				})
+			}
+			docLine = 0
+			continue
+		}
+
+		if erlangFunctionRegex.MatchString(head) {
+			if docLine == 0 {
+				docLine = startLine
+			}
+			functionKeys[len(symbols)] = e.functionKey(text, masked, start)
+			symbols = append(symbols, models.Symbol{
+				Prototype: e.functionPrototype(text, masked, start),
+				Purpose:   e.functionDoc(lines, docLine),
+				Params:    e.functionParams(text, masked, start),
+				Range:     []int{startLine, endLine},
+			})
+		}
+		docLine = 0
+	}
+
+	if moduleIndex >= 0 {
+		symbols[moduleIndex].Children = exports
+	}
+
+	exported := make(map[string]bool)
+	for _, export := range exports {
+		exported[export.Prototype] = true
+	}
+	for index, key := range functionKeys {
+		if spec, ok := specs[key]; ok {
+			e.applySpec(&symbols[index], spec)
+		}
+		symbols[index].QualifiedName = key
+		if moduleName != "" {
+			symbols[index].QualifiedName = moduleName + ":" + key
+		}
+		symbols[index].Visibility = visibilityPrivate
+		if exportAll || exported[key] {
+			symbols[index].Visibility = visibilityExported
+		}
+	}
+	return symbols
+}
+
+// functionKey 返回函数子句的 name/arity，与导出列表中的写法一致
+func (e *ErlangExtractor) functionKey(text, masked string, start int) string {
+	paren := strings.IndexByte(masked[start:], '(')
+	name := strings.TrimSpace(text[start : start+paren])
+	closeIdx := matchBrace(masked, start+paren)
+	if closeIdx < 0 {
+		return name
+	}
+
+	arity := 0
+	if strings.TrimSpace(masked[start+paren+1:closeIdx]) != "" {
+		arity = len(e.splitArguments(masked, start+paren+1, closeIdx))
+	}
+	return fmt.Sprintf("%s/%d", name, arity)
+}
+
+// splitArguments 按顶层逗号拆分已屏蔽文本的 [start, end) 区间，二进制 <<...>> 也作为括号处理
+func (e *ErlangExtractor) splitArguments(masked string, start, end int) [][2]int {
+	var segments [][2]int
+	segmentStart := start
+	binaries := 0
+	for i := start; i < end; i++ {
+		switch {
+		case masked[i] == '(' || masked[i] == '[' || masked[i] == '{':
+			if closeIdx := matchBrace(masked, i); closeIdx > i && closeIdx < end {
+				i = closeIdx
+			}
+		case strings.HasPrefix(masked[i:end], "<<"):
+			binaries++
+			i++
+		case strings.HasPrefix(masked[i:end], ">>") && binaries > 0:
+			binaries--
+			i++
+		case masked[i] == ',' && binaries == 0:
+			segments = append(segments, [2]int{segmentStart, i})
+			segmentStart = i + 1
+		}
+	}
+	return append(segments, [2]int{segmentStart, end})
+}
+
+// ExtractFilePurpose 提取文件开头的 % 注释块作为文件用途
+func (e *ErlangExtractor) ExtractFilePurpose(content []byte) string {
+	var commentLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "%") {
+			if trimmed == "" && len(commentLines) == 0 {
+				continue
+			}
+			break
+		}
+
+		comment := e.stripComment(trimmed)
+		// 跳过编辑器模式行
+		if comment != "" && !strings.Contains(comment, "-*-") {
+			commentLines = append(commentLines, comment)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// splitForms 按以 . 结尾的顶层形式拆分源码
+func (e *ErlangExtractor) splitForms(masked string) [][2]int {
+	var forms [][2]int
+	formStart := 0
+	for i := 0; i < len(masked); i++ {
+		switch masked[i] {
+		case '(', '[', '{':
+			if closeIdx := matchBrace(masked, i); closeIdx > i {
+				i = closeIdx
+			}
+		case '.':
+			// 形式以后跟空白的 . 结束（排除浮点数和记录字段访问）
+			if i+1 == len(masked) || strings.IndexByte(" \t\r\n", masked[i+1]) >= 0 {
+				if strings.TrimSpace(masked[formStart:i]) != "" {
+					forms = append(forms, [2]int{formStart, i + 1})
+				}
+				formStart = i + 1
+			}
+		}
+	}
+	return forms
+}
+
+// functionPrototype 提取第一个子句的头部（包括守卫），不包含 -> 之后的函数体
+func (e *ErlangExtractor) functionPrototype(text, masked string, start int) string {
+	paren := strings.IndexByte(masked[start:], '(')
+	if paren < 0 {
+		return ""
+	}
+	closeIdx := matchBrace(masked, start+paren)
+	if closeIdx < 0 {
+		return ""
+	}
+
+	end := closeIdx + 1
+	if arrow := strings.Index(masked[end:], "->"); arrow >= 0 {
+		end += arrow
+	}
+	return e.cleanText(text[start:end])
+}
+
+// functionParams 提取第一个子句的参数，参数名为模式的文本（类型来自 -spec）
+func (e *ErlangExtractor) functionParams(text, masked string, start int) []models.Param {
+	paren := start + strings.IndexByte(masked[start:], '(')
+	closeIdx := matchBrace(masked, paren)
+	if closeIdx < 0 {
+		return nil
+	}
+
+	var params []models.Param
+	for _, param := range paramSegments(text, e.splitArguments(masked, paren+1, closeIdx)) {
+		params = append(params, models.Param{Name: compactText(param)})
+	}
+	return params
+}
+
+// parseSpec 解析 -spec name(Arg :: Type, ...) -> Return 的第一个子句，返回 name/arity 和参数、返回类型
+func (e *ErlangExtractor) parseSpec(text, masked string, start, end int) (string, erlangSpec, bool) {
+	nameStart := skipSpace(masked, start+strings.Index(masked[start:end], "spec")+len("spec"))
+	paren := strings.IndexByte(masked[nameStart:end], '(')
+	if paren < 0 {
+		return "", erlangSpec{}, false
+	}
+	paren += nameStart
+	closeIdx := matchBrace(masked, paren)
+	if closeIdx < 0 || closeIdx >= end {
+		return "", erlangSpec{}, false
+	}
+
+	var spec erlangSpec
+	for _, arg := range paramSegments(text, e.splitArguments(masked, paren+1, closeIdx)) {
+		param := models.Param{Type: compactText(arg)}
+		if name, argType, ok := strings.Cut(arg, "::"); ok {
+			param = models.Param{Name: compactText(name), Type: compactText(argType)}
+		}
+		spec.params = append(spec.params, param)
+	}
+
+	if arrow := strings.Index(masked[closeIdx:end], "->"); arrow >= 0 {
+		returnStart := closeIdx + arrow + len("->")
+		returnEnd := end - 1 // 去掉结尾的 .
+		for i := returnStart; i < returnEnd; i++ {
+			switch masked[i] {
+			case '(', '[', '{':
+				if match := matchBrace(masked, i); match > i && match < returnEnd {
+					i = match
+				}
+			case ';':
+				returnEnd = i
+			case 'w':
+				// when 之后是类型变量的约束
+				if strings.HasPrefix(masked[i:], "when") && !isWordChar(masked[i-1]) && (i+4 >= len(masked) || !isWordChar(masked[i+4])) {
+					returnEnd = i
+				}
+			}
+		}
+		if returns := compactText(text[returnStart:returnEnd]); returns != "" {
+			spec.returns = []string{returns}
+		}
+		if strings.HasPrefix(masked[returnEnd:], "when") {
+			e.applyWhen(&spec, text, masked, returnEnd+len("when"), end-1)
+		}
+	}
+
+	key := fmt.Sprintf("%s/%d", strings.TrimSpace(text[nameStart:paren]), len(spec.params))
+	return key, spec, true
+}
+
+// applyWhen 用 when 子句中 Var :: Type 的约束替换只写了变量名的参数类型，变量名作为参数名
+func (e *ErlangExtractor) applyWhen(spec *erlangSpec, text, masked string, start, end int) {
+	if semicolon := strings.IndexByte(masked[start:end], ';'); semicolon >= 0 {
+		end = start + semicolon
+	}
+	constraints := make(map[string]string)
+	for _, constraint := range paramSegments(text, e.splitArguments(masked, start, end)) {
+		if name, constraintType, ok := strings.Cut(constraint, "::"); ok {
+			constraints[strings.TrimSpace(name)] = compactText(constraintType)
+		}
+	}
+	for i, param := range spec.params {
+		if constraintType, ok := constraints[param.Type]; ok && param.Name == "" {
+			spec.params[i] = models.Param{Name: param.Type, Type: constraintType}
+		}
+	}
+}
+
+// applySpec 把 -spec 中的类型按位置填入函数参数，函数模式为 _ 时使用 -spec 中的变量名
+func (e *ErlangExtractor) applySpec(symbol *models.Symbol, spec erlangSpec) {
+	for i := range symbol.Params {
+		if i >= len(spec.params) {
+			break
+		}
+		symbol.Params[i].Type = spec.params[i].Type
+		if symbol.Params[i].Name == "_" && spec.params[i].Name != "" {
+			symbol.Params[i].Name = spec.params[i].Name
+		}
+	}
+	symbol.Returns = spec.returns
+}
+
+// functionDoc 提取函数说明：-doc 属性或 -spec/函数之前的 % 注释
+func (e *ErlangExtractor) functionDoc(lines []string, docLine int) string {
+	// OTP 27 的 -doc "..." 属性
+	if docLine > 0 && docLine <= len(lines) {
+		line := strings.TrimSpace(lines[docLine-1])
+		if strings.HasPrefix(line, "-doc") {
+			line = strings.TrimPrefix(line, "-doc")
+			return strings.Trim(strings.TrimSpace(line), `"(). `)
+		}
+	}
+	return e.commentAbove(lines, docLine)
+}
+
+// commentAbove 提取指定行上方紧邻的 % 注释（去除 @doc 标记）
+func (e *ErlangExtractor) commentAbove(lines []string, line int) string {
+	var commentLines []string
+	for i := line - 2; i >= 0 && i < len(lines); i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, "%") {
+			break
+		}
+		if comment := e.stripComment(trimmed); comment != "" && !strings.HasPrefix(comment, "---") {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+	return strings.Join(commentLines, " ")
+}
+
+// stripComment 去除注释前缀和 edoc 的 @doc 标记
+func (e *ErlangExtractor) stripComment(line string) string {
+	comment := strings.TrimSpace(strings.TrimLeft(line, "%"))
+	return strings.TrimSpace(strings.TrimPrefix(comment, "@doc"))
+}
@@ -8,6 +8,28 @@ func NewExtractorFactory() *ExtractorFactory {
 	return &ExtractorFactory{}
 }
 
+// GetTextExtractor 获取没有 Tree-sitter 语法的语言对应的文本提取器
+func (f *ExtractorFactory) GetTextExtractor(language string) (TextExtractor, bool) {
+	switch language {
+	case "dart":
+		return NewDartExtractor(), true
+	case "erlang":
+		return NewErlangExtractor(), true
+	case "zig":
+		return NewZigExtractor(), true
+	case "haskell":
+		return NewHaskellExtractor(), true
+	case "objc":
+		return NewObjCExtractor(), true
+	case "make":
+		return NewMakeExtractor(), true
+	case "cmake":
+		return NewCMakeExtractor(), true
+	default:
+		return nil, false
+	}
+}
+
 // GetExtractor 根据语言获取对应的提取器
 func (f *ExtractorFactory) GetExtractor(language string) LanguageExtractor {
 	switch language {
@@ -23,10 +45,28 @@ func (f *ExtractorFactory) GetExtractor(language string) LanguageExtractor {
 		return NewCExtractor()
 	case "rust":
 		return NewRustExtractor()
-	case "javascript", "typescript":
+	case "javascript", "typescript", "tsx":
 		return NewJSExtractor()
 	case "python":
 		return NewPythonExtractor()
+	case "lua":
+		return NewLuaExtractor()
+	case "shell":
+		return NewShellExtractor()
+	case "sql":
+		return NewSQLExtractor()
+	case "protobuf":
+		return NewProtoExtractor()
+	case "hcl":
+		return NewHCLExtractor()
+	case "markdown":
+		return NewMarkdownExtractor()
+	case "elixir":
+		return NewElixirExtractor()
+	case "ocaml":
+		return NewOCamlExtractor()
+	case "dockerfile":
+		return NewDockerfileExtractor()
 	default:
 		// 默认返回Go提取器
 		return NewGoExtractor()
@@ -79,66 +79,75 @@ func extractMultiLineComments(node *sitter.Node, content []byte) string {
 	return ""
 }
 
-// extractXMLDocComments 提取XML文档注释（用于C#的 /// 格式）
-func extractXMLDocComments(node *sitter.Node, content []byte) string {
-	startPoint := node.StartPoint()
-	startRow := int(startPoint.Row)
-	lines := strings.Split(string(content), "\n")
+var (
+	// xmlDocSummaryRegex 匹配 <summary> 标签
+	xmlDocSummaryRegex = regexp.MustCompile(`(?s)<summary>(.*?)</summary>`)
 
-	var commentLines []string
+	// xmlDocParamRegex 匹配 <param name="x"> 标签
+	xmlDocParamRegex = regexp.MustCompile(`(?s)<param\s+name="([^"]*)"\s*>(.*?)</param>`)
 
-	for i := startRow - 1; i >= 0; i-- {
-		if i >= len(lines) {
-			continue
-		}
+	// xmlDocReturnsRegex 匹配 <returns> 标签
+	xmlDocReturnsRegex = regexp.MustCompile(`(?s)<returns>(.*?)</returns>`)
 
-		line := strings.TrimSpace(lines[i])
-		if line == "" {
-			continue
-		}
+	// xmlDocSectionRegex 匹配摘要之外的说明段落，没有 <summary> 时从正文中去掉
+	xmlDocSectionRegex = regexp.MustCompile(`(?s)<(param|typeparam|returns|exception|remarks|example|value)\b[^>]*>.*?</(param|typeparam|returns|exception|remarks|example|value)>`)
 
-		// 检查XML文档注释
-		if strings.HasPrefix(line, "///") {
-			comment := strings.TrimSpace(strings.TrimPrefix(line, "///"))
-			// 移除XML标签
-			comment = strings.TrimSpace(comment)
+	// xmlDocRefRegex 匹配 <see cref="X"/>、<paramref name="x"/> 等引用，保留被引用的名称
+	xmlDocRefRegex = regexp.MustCompile(`<(?:see|seealso|paramref|typeparamref)\s+(?:cref|name|langword|href)="([^"]*)"\s*/>`)
 
-			// 跳过纯标签行
-			if comment == "<summary>" || comment == "</summary>" || comment == "<param>" || comment == "</param>" || comment == "<returns>" || comment == "</returns>" {
-				continue
-			}
+	// xmlDocTagRegex 匹配其余 XML 标签
+	xmlDocTagRegex = regexp.MustCompile(`<[^>]+>`)
+)
 
-			comment = strings.TrimPrefix(comment, "<summary>")
-			comment = strings.TrimSuffix(comment, "</summary>")
-			if strings.HasPrefix(comment, "<param") {
-				// 提取参数描述
-				if idx := strings.Index(comment, ">"); idx > 0 {
-					comment = comment[idx+1:]
-				}
-			}
-			comment = strings.TrimPrefix(comment, "<returns>")
-			comment = strings.TrimSuffix(comment, "</returns>")
-			comment = strings.TrimSuffix(comment, "</param>")
+// extractXMLDocComments 提取XML文档注释（用于C#的 /// 格式）的摘要
+func extractXMLDocComments(node *sitter.Node, content []byte) string {
+	summary, _ := parseXMLDocComments(node, content)
+	return summary
+}
 
-			comment = strings.TrimSpace(comment)
-			if comment != "" {
-				commentLines = append([]string{comment}, commentLines...)
-			}
-			continue
-		}
+// parseXMLDocComments 解析节点上方的 /// XML 文档注释：<summary> 作为摘要，<param> 和 <returns>
+// 解析为结构化说明；没有 <summary> 时去掉其他段落后的正文作为摘要
+func parseXMLDocComments(node *sitter.Node, content []byte) (string, *models.DocComment) {
+	startRow := int(node.StartPoint().Row)
+	lines := strings.Split(string(content), "\n")
 
-		// 如果遇到非注释行，停止查找
-		if !strings.HasPrefix(line, "///") && line != "" {
+	var commentLines []string
+	for i := startRow - 1; i >= 0 && i < len(lines); i-- {
+		line := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(line, "///") {
 			break
 		}
+		commentLines = append([]string{strings.TrimSpace(strings.TrimPrefix(line, "///"))}, commentLines...)
+	}
+	if len(commentLines) == 0 {
+		return "", nil
 	}
+	text := strings.Join(commentLines, "\n")
 
-	// 如果有收集到的XML文档注释，合并它们
-	if len(commentLines) > 0 {
-		return strings.Join(commentLines, " ")
+	summary := xmlDocSectionRegex.ReplaceAllString(text, "")
+	if match := xmlDocSummaryRegex.FindStringSubmatch(text); match != nil {
+		summary = match[1]
 	}
 
-	return ""
+	var doc models.DocComment
+	for _, match := range xmlDocParamRegex.FindAllStringSubmatch(text, -1) {
+		doc.Params = append(doc.Params, models.DocParam{Name: match[1], Description: cleanXMLDocText(match[2])})
+	}
+	if match := xmlDocReturnsRegex.FindStringSubmatch(text); match != nil {
+		doc.Returns = &models.DocParam{Description: cleanXMLDocText(match[1])}
+	}
+	if len(doc.Params) == 0 && doc.Returns == nil {
+		return cleanXMLDocText(summary), nil
+	}
+	return cleanXMLDocText(summary), &doc
+}
+
+// cleanXMLDocText 把 XML 文档注释片段转换为纯文本：引用保留名称，其余标签去掉，空白合并
+func cleanXMLDocText(text string) string {
+	text = xmlDocRefRegex.ReplaceAllString(text, "$1")
+	text = xmlDocTagRegex.ReplaceAllString(text, "")
+	text = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&quot;", `"`, "&amp;", "&").Replace(text)
+	return strings.Join(strings.Fields(text), " ")
 }
 
 // LanguageExtractor 语言提取器接口
@@ -165,6 +174,37 @@ type LanguageExtractor interface {
 	ExtractComments(node *sitter.Node, content []byte) string
 }
 
+// TreeExtractor 可选接口，由需要自行遍历整棵语法树来组织符号的提取器实现
+// （例如需要把分散定义的方法归组到同一个表下的 Lua）
+type TreeExtractor interface {
+	// ExtractSymbols 从根节点提取文件中的全部符号
+	ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol
+}
+
+// TextExtractor 没有可用 Tree-sitter 语法的语言使用的提取器，直接基于源码文本提取符号
+type TextExtractor interface {
+	// ExtractSymbols 从源码文本提取文件中的全部符号
+	ExtractSymbols(content []byte) []models.Symbol
+}
+
+// FilePurposeExtractor 可选接口，由文件头部注释格式与默认规则（// 注释）不同的提取器实现
+type FilePurposeExtractor interface {
+	// ExtractFilePurpose 提取文件用途
+	ExtractFilePurpose(content []byte) string
+}
+
+// VisibilityExtractor 可选接口，由基于查询规则提取符号的提取器实现，用于填充符号的可见性和修饰符
+type VisibilityExtractor interface {
+	// ExtractVisibility 返回节点声明的可见性和修饰符
+	ExtractVisibility(node *sitter.Node, content []byte) (string, []string)
+}
+
+// SignatureExtractor 可选接口，由基于查询规则提取符号的提取器实现，用于填充函数的类型参数、参数和返回值
+type SignatureExtractor interface {
+	// ExtractSignature 返回节点声明的类型参数、参数列表和返回值类型
+	ExtractSignature(node *sitter.Node, content []byte) ([]models.TypeParam, []models.Param, []string)
+}
+
 // BaseExtractor 基础提取器，提供通用功能
 type BaseExtractor struct{}
 
@@ -195,6 +235,14 @@ func (b *BaseExtractor) cleanText(text string) string {
 	return text
 }
 
+// truncateRunes 把超过 limit 个字符的文本截断并追加 " ..."
+func truncateRunes(text string, limit int) string {
+	if runes := []rune(text); len(runes) > limit {
+		return string(runes[:limit]) + " ..."
+	}
+	return text
+}
+
 // extractFullNode 提取完整节点内容
 func (b *BaseExtractor) extractFullNode(node *sitter.Node, content []byte) string {
 	fullText := string(content[node.StartByte():node.EndByte()])
@@ -143,3 +143,107 @@ func (g *GoExtractor) extractGoComments(node *sitter.Node, content []byte) strin
 
 	return ""
 }
+
+// ExtractVisibility 按 Go 的导出规则判断可见性：名称以大写字母开头为 exported，否则为 package。
+// 分组声明（如 const ( ... )）中只要有一个名称导出即视为导出
+func (g *GoExtractor) ExtractVisibility(node *sitter.Node, content []byte) (string, []string) {
+	for _, name := range g.declaredNames(node, content) {
+		if isCapitalized(name) {
+			return visibilityExported, nil
+		}
+	}
+	return visibilityPackage, nil
+}
+
+// declaredNames 返回声明节点中声明的全部名称
+func (g *GoExtractor) declaredNames(node *sitter.Node, content []byte) []string {
+	if name := node.ChildByFieldName("name"); name != nil {
+		return []string{name.Content(content)}
+	}
+
+	var names []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "type_spec", "type_alias", "var_spec", "const_spec":
+			for j := 0; j < int(child.ChildCount()); j++ {
+				if child.FieldNameForChild(j) == "name" {
+					names = append(names, child.Child(j).Content(content))
+				}
+			}
+		case "var_spec_list", "const_spec_list":
+			names = append(names, g.declaredNames(child, content)...)
+		}
+	}
+	return names
+}
+
+// ExtractSignature 提取函数和方法的类型参数、参数和返回值，以及泛型类型声明的类型参数
+func (g *GoExtractor) ExtractSignature(node *sitter.Node, content []byte) ([]models.TypeParam, []models.Param, []string) {
+	switch node.Type() {
+	case "function_declaration", "method_declaration":
+		var returns []string
+		if result := node.ChildByFieldName("result"); result != nil {
+			if result.Type() == "parameter_list" {
+				for _, param := range g.extractParams(result, content) {
+					returns = append(returns, param.Type)
+				}
+			} else {
+				returns = []string{nodeText(result, content)}
+			}
+		}
+		return g.extractTypeParams(node.ChildByFieldName("type_parameters"), content),
+			g.extractParams(node.ChildByFieldName("parameters"), content), returns
+
+	case "type_declaration":
+		// 分组声明（type ( ... )）涉及多个类型，不合并它们的类型参数
+		if node.NamedChildCount() == 1 {
+			spec := node.NamedChild(0)
+			return g.extractTypeParams(spec.ChildByFieldName("type_parameters"), content), nil, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// extractParams 解析参数列表，a, b int 形式的共享类型展开为多个参数
+func (g *GoExtractor) extractParams(list *sitter.Node, content []byte) []models.Param {
+	if list == nil {
+		return nil
+	}
+
+	var params []models.Param
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		declaration := list.NamedChild(i)
+		variadic := declaration.Type() == "variadic_parameter_declaration"
+		if declaration.Type() != "parameter_declaration" && !variadic {
+			continue
+		}
+
+		paramType := fieldText(declaration, "type", content)
+		names := fieldNodes(declaration, "name")
+		if len(names) == 0 {
+			params = append(params, models.Param{Type: paramType, Variadic: variadic})
+		}
+		for _, name := range names {
+			params = append(params, models.Param{Name: name.Content(content), Type: paramType, Variadic: variadic})
+		}
+	}
+	return params
+}
+
+// extractTypeParams 解析类型参数列表，[K, V any] 形式的共享约束展开为多个类型参数
+func (g *GoExtractor) extractTypeParams(list *sitter.Node, content []byte) []models.TypeParam {
+	if list == nil {
+		return nil
+	}
+
+	var typeParams []models.TypeParam
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		declaration := list.NamedChild(i)
+		constraint := fieldText(declaration, "type", content)
+		for _, name := range fieldNodes(declaration, "name") {
+			typeParams = append(typeParams, models.TypeParam{Name: name.Content(content), Constraint: constraint})
+		}
+	}
+	return typeParams
+}
@@ -0,0 +1,737 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// haskellSyntax Haskell 的注释和字符串语法（' 同时用于标识符，不作为引号处理）
+	haskellSyntax = textSyntax{
+		lineComments:  []string{"--"},
+		blockComments: [][2]string{{"{-", "-}"}},
+		quotes:        `"`,
+	}
+
+	// haskellCommentSyntax 只屏蔽注释，用于生成不含注释的原型
+	haskellCommentSyntax = textSyntax{
+		lineComments:  haskellSyntax.lineComments,
+		blockComments: haskellSyntax.blockComments,
+	}
+
+	// haskellModuleRegex 匹配模块声明
+	haskellModuleRegex = regexp.MustCompile(`^module\s+([\w.']+)`)
+
+	// haskellDataRegex 匹配 data/newtype 声明（包括 data family 和 data instance）
+	haskellDataRegex = regexp.MustCompile(`^(data|newtype)\b`)
+
+	// haskellClassRegex 匹配类型类和实例声明
+	haskellClassRegex = regexp.MustCompile(`^(class|instance)\b`)
+
+	// haskellSkipRegex 匹配不输出的顶层声明
+	haskellSkipRegex = regexp.MustCompile(`^(import|infix[lr]?|deriving|default)\b`)
+
+	// haskellSignatureRegex 匹配类型签名，如 foo, bar :: Int 或 (<+>) :: a -> a -> a
+	haskellSignatureRegex = regexp.MustCompile(`^([a-z_][\w']*|\([^\w\s)]+\))(\s*,\s*([a-z_][\w']*|\([^\w\s)]+\)))*\s*::`)
+
+	// haskellDefinitionRegex 匹配函数或变量定义的名称
+	haskellDefinitionRegex = regexp.MustCompile(`^([a-z_][\w']*|\([^\w\s)]+\))`)
+
+	// haskellInfixDefinitionRegex 匹配中缀形式的运算符定义，如 x <+> y = ...
+	haskellInfixDefinitionRegex = regexp.MustCompile("^[a-z_][\\w']*\\s+([!#$%&*+./<=>?@\\\\^|~:-]+|`[\\w']+`)\\s")
+
+	// haskellWhereRegex 匹配 where 关键字
+	haskellWhereRegex = regexp.MustCompile(`\bwhere\b`)
+
+	// haskellDerivingRegex 匹配 deriving 子句
+	haskellDerivingRegex = regexp.MustCompile(`^deriving\b`)
+
+	// haskellTypeNameRegex 匹配 data/newtype/type/class 声明的类型名称（跳过类型类约束）
+	haskellTypeNameRegex = regexp.MustCompile(`^(?:data|newtype|type|class)\s+(?:family\s+|instance\s+)?(?:[^=]*=>\s*)?([A-Z][\w']*|\([^\w\s)]+\))`)
+
+	// haskellDescriptionRegex 匹配模块头注释中的 Description 字段
+	haskellDescriptionRegex = regexp.MustCompile(`(?m)^\s*Description\s*:\s*(.+?)\s*$`)
+)
+
+// haskellOperatorChars 组成 Haskell 运算符的字符
+const haskellOperatorChars = "!#$%&*+./<=>?@\\^|-~:"
+
+// HaskellExtractor Haskell语言提取器（基于文本，没有可用的 Tree-sitter 语法）
+type HaskellExtractor struct {
+	BaseExtractor
+}
+
+// NewHaskellExtractor 创建Haskell语言提取器
+func NewHaskellExtractor() *HaskellExtractor {
+	return &HaskellExtractor{}
+}
+
+// haskellChunk 按布局规则拆分出的一个声明（起始列不大于所在块的布局列）
+type haskellChunk struct {
+	start     int    // 声明开始位置
+	end       int    // 声明结束位置（不含）
+	startLine int    // 开始行
+	endLine   int    // 结束行（最后一个非空行）
+	head      string // 屏蔽后的声明文本
+}
+
+// haskellSource 提取过程中共享的源码
+type haskellSource struct {
+	source string   // 去除注释后的源码，用于生成原型
+	masked string   // 屏蔽注释和字符串后的源码，用于识别结构
+	lines  []string // 原始源码行，用于提取 Haddock 注释
+}
+
+// ExtractSymbols 提取模块（导出列表作为子符号）、data/newtype/type 声明（构造器作为子符号）、
+// 类型类和实例（成员作为方法），以及与定义配对的顶层函数签名
+func (h *HaskellExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	text := string(content)
+	src := haskellSource{
+		source: maskSource(text, haskellCommentSyntax),
+		masked: maskSource(text, haskellSyntax),
+		lines:  strings.Split(text, "\n"),
+	}
+
+	symbols := h.extractDeclarations(src, h.splitChunks(src.masked, 0, len(src.masked)))
+	h.markExports(symbols)
+	return symbols
+}
+
+// markExports 按模块的导出列表设置顶层声明的可见性：列表中的名称为 exported，其他为 private；
+// 构造器、记录字段和类型类方法只有通过 T(..) 或 T(A, b) 导出时才是 exported。
+// 没有导出列表的模块导出全部声明，没有模块声明的文件（Main）只导出 main。实例总是导出，不记录可见性
+func (h *HaskellExtractor) markExports(symbols []models.Symbol) {
+	exports := map[string][]string{"main": nil}
+	exportAll := false
+	for _, symbol := range symbols {
+		if !strings.HasPrefix(symbol.Prototype, "module ") {
+			continue
+		}
+		exports = make(map[string][]string)
+		exportAll = len(symbol.Children) == 0
+		for _, item := range symbol.Children {
+			name, members := h.exportItem(item.Prototype)
+			exports[name] = members
+		}
+	}
+
+	for i := range symbols {
+		symbol := &symbols[i]
+		if strings.HasPrefix(symbol.Prototype, "module ") || strings.HasPrefix(symbol.Prototype, "instance ") {
+			continue
+		}
+		name := h.declarationName(symbol.Prototype)
+		if name == "" {
+			continue
+		}
+		members, exported := exports[name]
+		symbol.Visibility = visibilityPrivate
+		if exportAll || exported {
+			symbol.Visibility = visibilityExported
+		}
+		h.markMembers(symbol.Methods, members, exportAll)
+		h.markMembers(symbol.Children, members, exportAll)
+	}
+}
+
+// markMembers 设置构造器、记录字段或类型类方法的可见性，members 为导出项括号中的名称（.. 表示全部）
+func (h *HaskellExtractor) markMembers(symbols []models.Symbol, members []string, exportAll bool) {
+	for i := range symbols {
+		symbol := &symbols[i]
+		exported := exportAll
+		name := h.declarationName(symbol.Prototype)
+		if name == "" {
+			name = strings.SplitN(symbol.Prototype, " ", 2)[0]
+		}
+		for _, member := range members {
+			if member == ".." || member == name {
+				exported = true
+			}
+		}
+		symbol.Visibility = visibilityPrivate
+		if exported {
+			symbol.Visibility = visibilityExported
+		}
+		h.markMembers(symbol.Children, members, exportAll)
+	}
+}
+
+// exportItem 拆分导出项，如 Shape(..) 返回 Shape 和 [..]，Tree(Leaf, Node) 返回 Tree 和 [Leaf Node]
+func (h *HaskellExtractor) exportItem(item string) (string, []string) {
+	item = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(item, "type "), "pattern "))
+	if strings.HasPrefix(item, "(") {
+		// 运算符，如 (<+>)
+		if closeIdx := strings.IndexByte(item, ')'); closeIdx > 0 {
+			return item[:closeIdx+1], nil
+		}
+		return item, nil
+	}
+
+	open := strings.IndexByte(item, '(')
+	if open < 0 {
+		return item, nil
+	}
+	var members []string
+	for _, member := range strings.Split(strings.TrimSuffix(item[open+1:], ")"), ",") {
+		if member = strings.TrimSpace(member); member != "" {
+			members = append(members, member)
+		}
+	}
+	return strings.TrimSpace(item[:open]), members
+}
+
+// declarationName 获取顶层声明的名称：类型声明的类型名，或函数签名和定义的函数名
+func (h *HaskellExtractor) declarationName(prototype string) string {
+	if match := haskellTypeNameRegex.FindStringSubmatch(prototype); match != nil {
+		return match[1]
+	}
+	if strings.HasPrefix(prototype, "type ") || strings.HasPrefix(prototype, "data ") ||
+		strings.HasPrefix(prototype, "newtype ") || strings.HasPrefix(prototype, "class ") ||
+		strings.HasPrefix(prototype, "foreign ") {
+		return ""
+	}
+	if haskellSignatureRegex.MatchString(prototype) {
+		return haskellDefinitionRegex.FindString(prototype)
+	}
+	return h.definitionName(prototype)
+}
+
+// ExtractFilePurpose 提取模块头注释的 Description 字段，否则使用模块声明上方的 Haddock 注释
+func (h *HaskellExtractor) ExtractFilePurpose(content []byte) string {
+	text := string(content)
+	masked := maskSource(text, haskellSyntax)
+	codeStart := skipSpace(masked, 0)
+
+	if match := haskellDescriptionRegex.FindStringSubmatch(text[:codeStart]); match != nil {
+		return match[1]
+	}
+	if haskellModuleRegex.MatchString(masked[codeStart:]) {
+		return h.haddockAbove(strings.Split(text, "\n"), lineOfOffset(masked, codeStart))
+	}
+	return ""
+}
+
+// splitChunks 按布局规则拆分 [start, end) 区间：从区间第一个声明的列开始，
+// 列不大于该列且不在括号内的行开始新的声明
+func (h *HaskellExtractor) splitChunks(masked string, start, end int) []haskellChunk {
+	first := skipSpace(masked, start)
+	if first >= end {
+		return nil
+	}
+	column := first - (strings.LastIndexByte(masked[:first], '\n') + 1)
+
+	var chunks []haskellChunk
+	chunkStart := first
+	finish := func(chunkEnd int) {
+		head := strings.TrimSpace(masked[chunkStart:chunkEnd])
+		if head == "" {
+			return
+		}
+		last := chunkStart + len(strings.TrimRight(masked[chunkStart:chunkEnd], " \t\r\n")) - 1
+		chunks = append(chunks, haskellChunk{
+			start:     chunkStart,
+			end:       last + 1,
+			startLine: lineOfOffset(masked, chunkStart),
+			endLine:   lineOfOffset(masked, last),
+			head:      head,
+		})
+	}
+
+	depth := 0
+	for lineStart := first; lineStart < end; {
+		lineEnd := end
+		if idx := strings.IndexByte(masked[lineStart:end], '\n'); idx >= 0 {
+			lineEnd = lineStart + idx
+		}
+		line := masked[lineStart:lineEnd]
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := lineStart - (strings.LastIndexByte(masked[:lineStart], '\n') + 1) + len(line) - len(trimmed)
+
+		if strings.TrimSpace(trimmed) != "" && indent <= column && depth <= 0 && lineStart > first {
+			finish(lineStart)
+			chunkStart = lineStart + len(line) - len(trimmed)
+			depth = 0
+		}
+		depth += strings.Count(line, "(") + strings.Count(line, "[") + strings.Count(line, "{") -
+			strings.Count(line, ")") - strings.Count(line, "]") - strings.Count(line, "}")
+
+		lineStart = lineEnd + 1
+	}
+	finish(end)
+
+	return chunks
+}
+
+// extractDeclarations 将声明转换为符号，类型签名与随后同名的定义（包括多个方程）合并为一个符号
+func (h *HaskellExtractor) extractDeclarations(src haskellSource, chunks []haskellChunk) []models.Symbol {
+	var symbols []models.Symbol
+	functionName := "" // 最后一个函数符号的名称，用于合并签名和定义
+	merged := false    // 是否已合并过最后一个函数符号的第一个方程，参数名只取自第一个方程
+
+	for _, chunk := range chunks {
+		symbol := models.Symbol{
+			Prototype: h.cleanText(src.source[chunk.start:chunk.end]),
+			Purpose:   h.haddock(src.lines, chunk.startLine, chunk.endLine),
+			Range:     []int{chunk.startLine, chunk.endLine},
+		}
+
+		name := ""
+		switch {
+		case haskellSkipRegex.MatchString(chunk.head):
+			continue
+
+		case haskellModuleRegex.MatchString(chunk.head):
+			symbol = h.moduleSymbol(src, chunk, symbol)
+
+		case haskellDataRegex.MatchString(chunk.head):
+			symbol = h.dataSymbol(src, chunk, symbol)
+			symbol.TypeParams = h.headTypeParams(chunk.head)
+
+		case strings.HasPrefix(chunk.head, "type ") || strings.HasPrefix(chunk.head, "foreign "):
+			// 类型别名、类型族和 FFI 声明保留完整文本
+
+		case haskellClassRegex.MatchString(chunk.head):
+			symbol = h.classSymbol(src, chunk, symbol)
+			symbol.TypeParams = h.headTypeParams(chunk.head)
+
+		case haskellSignatureRegex.MatchString(chunk.head):
+			name = haskellDefinitionRegex.FindString(chunk.head)
+			symbol.TypeParams, symbol.Params, symbol.Returns = h.typeSignature(src, chunk.start, chunk.end)
+
+		default:
+			equals := h.findOperator(src.masked, chunk.start, chunk.end, "=")
+			if equals < 0 {
+				// Template Haskell 拼接等没有定义的顶层表达式
+				continue
+			}
+			name = h.definitionName(chunk.head)
+			if name == functionName && len(symbols) > 0 {
+				last := &symbols[len(symbols)-1]
+				last.Range = mergeRange(last.Range, symbol.Range)
+				if last.Purpose == "" {
+					last.Purpose = symbol.Purpose
+				}
+				if !merged {
+					h.nameParams(last.Params, h.equationHead(src, chunk))
+					merged = true
+				}
+				continue
+			}
+			symbol.Prototype = h.equationHead(src, chunk)
+		}
+
+		functionName, merged = name, false
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols
+}
+
+// moduleSymbol 创建模块符号，导出列表中的每一项作为子符号
+func (h *HaskellExtractor) moduleSymbol(src haskellSource, chunk haskellChunk, symbol models.Symbol) models.Symbol {
+	nameEnd := chunk.start + haskellModuleRegex.FindStringIndex(src.masked[chunk.start:chunk.end])[1]
+	symbol.Prototype = h.cleanText(src.source[chunk.start:nameEnd])
+
+	open := skipSpace(src.masked, nameEnd)
+	if open >= chunk.end || src.masked[open] != '(' {
+		return symbol
+	}
+	closeIdx := matchBrace(src.masked, open)
+	if closeIdx < 0 {
+		return symbol
+	}
+
+	for _, segment := range splitTopLevelCommas(src.masked, open+1, closeIdx) {
+		if start := skipSpace(src.masked, segment[0]); start < segment[1] {
+			symbol.Children = append(symbol.Children, h.simpleSymbol(src, start, segment[1]))
+		}
+	}
+	return symbol
+}
+
+// dataSymbol 创建 data/newtype 符号，构造器作为子符号，记录字段作为构造器的子符号
+func (h *HaskellExtractor) dataSymbol(src haskellSource, chunk haskellChunk, symbol models.Symbol) models.Symbol {
+	equals := h.findOperator(src.masked, chunk.start, chunk.end, "=")
+	where := -1
+	if loc := haskellWhereRegex.FindStringIndex(src.masked[chunk.start:chunk.end]); loc != nil {
+		where = chunk.start + loc[0]
+	}
+
+	// GADT 语法：where 之后每行一个构造器签名
+	if where >= 0 && (equals < 0 || where < equals) {
+		symbol.Prototype = h.cleanText(src.source[chunk.start:where])
+		for _, constructor := range h.splitChunks(src.masked, where+len("where"), chunk.end) {
+			if strings.Contains(constructor.head, "::") {
+				symbol.Children = append(symbol.Children, h.simpleSymbol(src, constructor.start, constructor.end))
+			}
+		}
+		return symbol
+	}
+	if equals < 0 {
+		return symbol
+	}
+
+	symbol.Prototype = h.cleanText(src.source[chunk.start:equals])
+	segmentStart := equals + 1
+	for i := segmentStart; i <= chunk.end; i++ {
+		if i < chunk.end {
+			switch c := src.masked[i]; {
+			case c == '(' || c == '[' || c == '{':
+				if closeIdx := matchBrace(src.masked, i); closeIdx > i && closeIdx < chunk.end {
+					i = closeIdx
+				}
+				continue
+			case c == '|' && h.isOperatorAt(src.masked, i, "|"):
+			case c == 'd' && haskellDerivingRegex.MatchString(src.masked[i:chunk.end]) && (i == 0 || !isWordChar(src.masked[i-1])):
+			default:
+				continue
+			}
+		}
+
+		if constructor, ok := h.constructorSymbol(src, segmentStart, i); ok {
+			symbol.Children = append(symbol.Children, constructor)
+		}
+		if i < chunk.end && src.masked[i] != '|' {
+			// deriving 子句之后没有构造器
+			break
+		}
+		segmentStart = i + 1
+	}
+
+	return symbol
+}
+
+// constructorSymbol 创建构造器符号，记录语法的字段作为子符号
+func (h *HaskellExtractor) constructorSymbol(src haskellSource, start, end int) (models.Symbol, bool) {
+	start = skipSpace(src.masked, start)
+	if strings.TrimSpace(src.masked[start:end]) == "" {
+		return models.Symbol{}, false
+	}
+
+	brace := strings.IndexByte(src.masked[start:end], '{')
+	if brace < 0 {
+		return h.simpleSymbol(src, start, end), true
+	}
+	brace += start
+	closeIdx := matchBrace(src.masked, brace)
+	if closeIdx < 0 || closeIdx >= end {
+		return h.simpleSymbol(src, start, end), true
+	}
+
+	symbol := h.simpleSymbol(src, start, end)
+	symbol.Prototype = h.cleanText(src.source[start:brace])
+	for _, segment := range splitTopLevelCommas(src.masked, brace+1, closeIdx) {
+		if fieldStart := skipSpace(src.masked, segment[0]); fieldStart < segment[1] {
+			symbol.Children = append(symbol.Children, h.simpleSymbol(src, fieldStart, segment[1]))
+		}
+	}
+	return symbol, true
+}
+
+// classSymbol 创建类型类或实例符号，where 之后的签名和定义作为方法，关联类型作为子符号
+func (h *HaskellExtractor) classSymbol(src haskellSource, chunk haskellChunk, symbol models.Symbol) models.Symbol {
+	loc := haskellWhereRegex.FindStringIndex(src.masked[chunk.start:chunk.end])
+	if loc == nil {
+		return symbol
+	}
+	where := chunk.start + loc[0]
+	symbol.Prototype = h.cleanText(src.source[chunk.start:where])
+
+	for _, member := range h.extractDeclarations(src, h.splitChunks(src.masked, where+len("where"), chunk.end)) {
+		if strings.HasPrefix(member.Prototype, "type ") || strings.HasPrefix(member.Prototype, "data ") {
+			symbol.Children = append(symbol.Children, member)
+		} else {
+			symbol.Methods = append(symbol.Methods, member)
+		}
+	}
+	return symbol
+}
+
+// simpleSymbol 创建 [start, end) 区间对应的符号，说明来自上方的 -- | 或之后的 -- ^ 注释
+func (h *HaskellExtractor) simpleSymbol(src haskellSource, start, end int) models.Symbol {
+	end = start + len(strings.TrimRight(src.masked[start:end], " \t\r\n"))
+	startLine := lineOfOffset(src.masked, start)
+	endLine := lineOfOffset(src.masked, end-1)
+	return models.Symbol{
+		Prototype: h.cleanText(src.source[start:end]),
+		Purpose:   h.haddock(src.lines, startLine, endLine),
+		Range:     []int{startLine, endLine},
+	}
+}
+
+// definitionName 获取定义的函数名，中缀定义返回带括号的运算符
+func (h *HaskellExtractor) definitionName(head string) string {
+	if match := haskellInfixDefinitionRegex.FindStringSubmatch(head); match != nil && match[1] != "=" && match[1] != "|" {
+		return "(" + strings.Trim(match[1], "`") + ")"
+	}
+	return haskellDefinitionRegex.FindString(head)
+}
+
+// equationHead 提取方程的左侧（函数名和参数），不包含守卫和 = 之后的函数体
+func (h *HaskellExtractor) equationHead(src haskellSource, chunk haskellChunk) string {
+	end := chunk.end
+	for _, operator := range []string{"=", "|"} {
+		if idx := h.findOperator(src.masked, chunk.start, chunk.end, operator); idx >= 0 && idx < end {
+			end = idx
+		}
+	}
+	return h.cleanText(src.source[chunk.start:end])
+}
+
+// typeSignature 解析 :: 之后的类型：forall 和上下文中的类型变量作为类型参数，
+// 顶层 -> 分隔的各部分除最后一个外作为参数类型，最后一个作为返回类型
+func (h *HaskellExtractor) typeSignature(src haskellSource, start, end int) ([]models.TypeParam, []models.Param, []string) {
+	colon := h.findOperator(src.masked, start, end, "::")
+	if colon < 0 {
+		return nil, nil, nil
+	}
+	typeStart := skipSpace(src.masked, colon+len("::"))
+
+	var typeParams []models.TypeParam
+	if strings.HasPrefix(src.masked[typeStart:end], "forall ") {
+		if dot := h.findOperator(src.masked, typeStart, end, "."); dot >= 0 {
+			for _, name := range strings.Fields(src.masked[typeStart+len("forall") : dot]) {
+				typeParams = append(typeParams, models.TypeParam{Name: strings.Trim(name, "(){}")})
+			}
+			typeStart = skipSpace(src.masked, dot+1)
+		}
+	}
+
+	context := ""
+	if arrow := h.findOperator(src.masked, typeStart, end, "=>"); arrow >= 0 {
+		context = src.source[typeStart:arrow]
+		typeStart = arrow + len("=>")
+	}
+
+	var parts []string
+	for partStart := typeStart; ; {
+		arrow := h.findOperator(src.masked, partStart, end, "->")
+		if arrow < 0 {
+			parts = append(parts, h.cleanText(src.source[partStart:end]))
+			break
+		}
+		parts = append(parts, h.cleanText(src.source[partStart:arrow]))
+		partStart = arrow + len("->")
+	}
+
+	if len(typeParams) == 0 {
+		typeParams = h.typeVariables(src.masked[typeStart:end])
+	}
+	typeParams = h.applyContext(typeParams, context)
+
+	var params []models.Param
+	for _, part := range parts[:len(parts)-1] {
+		params = append(params, models.Param{Type: part})
+	}
+	return typeParams, params, []string{parts[len(parts)-1]}
+}
+
+// typeVariables 按出现顺序收集类型中的类型变量（小写开头且不是限定名的一部分的标识符）
+func (h *HaskellExtractor) typeVariables(text string) []models.TypeParam {
+	var typeParams []models.TypeParam
+	seen := make(map[string]bool)
+	for i := 0; i < len(text); i++ {
+		if !isWordChar(text[i]) {
+			continue
+		}
+		start := i
+		for i < len(text) && isWordChar(text[i]) {
+			i++
+		}
+		word := text[start:i]
+		if c := word[0]; (c < 'a' || c > 'z') && c != '_' || start > 0 && text[start-1] == '.' || word == "forall" || seen[word] {
+			continue
+		}
+		seen[word] = true
+		typeParams = append(typeParams, models.TypeParam{Name: word})
+	}
+	return typeParams
+}
+
+// applyContext 把上下文中 Class a 形式的约束记录到对应类型变量上，同一变量的多个约束以逗号连接
+func (h *HaskellExtractor) applyContext(typeParams []models.TypeParam, context string) []models.TypeParam {
+	context = strings.TrimSpace(context)
+	if strings.HasPrefix(context, "(") && strings.HasSuffix(context, ")") {
+		context = context[1 : len(context)-1]
+	}
+	for _, constraint := range splitTypeList(context) {
+		fields := strings.Fields(constraint)
+		if len(fields) != 2 {
+			continue
+		}
+		for i := range typeParams {
+			if typeParams[i].Name != fields[1] {
+				continue
+			}
+			if typeParams[i].Constraint != "" {
+				typeParams[i].Constraint += ", "
+			}
+			typeParams[i].Constraint += fields[0]
+		}
+	}
+	return typeParams
+}
+
+// nameParams 用第一个方程中的简单变量模式为签名得到的参数命名，模式不是变量或数量不符时保持不变
+func (h *HaskellExtractor) nameParams(params []models.Param, head string) {
+	args := strings.Fields(head)
+	if len(args) == 0 || len(args)-1 != len(params) {
+		return
+	}
+	for _, arg := range args[1:] {
+		if c := arg[0]; (c < 'a' || c > 'z') && c != '_' {
+			return
+		}
+		for i := 0; i < len(arg); i++ {
+			if !isWordChar(arg[i]) {
+				return
+			}
+		}
+	}
+	for i, arg := range args[1:] {
+		if arg != "_" {
+			params[i].Name = arg
+		}
+	}
+}
+
+// headTypeParams 提取 data/newtype/class 声明头部类型名之后的类型变量，如 data Map k v 中的 k 和 v
+func (h *HaskellExtractor) headTypeParams(head string) []models.TypeParam {
+	loc := haskellTypeNameRegex.FindStringSubmatchIndex(head)
+	if loc == nil {
+		return nil
+	}
+	rest := head[loc[1]:]
+	if where := haskellWhereRegex.FindStringIndex(rest); where != nil {
+		rest = rest[:where[0]]
+	}
+	for _, operator := range []string{"=", "|"} {
+		if idx := strings.Index(rest, operator); idx >= 0 {
+			rest = rest[:idx]
+		}
+	}
+
+	var typeParams []models.TypeParam
+	for i := 0; i < len(rest); i++ {
+		start, end := i, i
+		switch {
+		case rest[i] == '(':
+			// 带种类标注的类型变量，如 (f :: * -> *)
+			closeIdx := matchBrace(rest, i)
+			if closeIdx < 0 {
+				return typeParams
+			}
+			start, end, i = i+1, closeIdx, closeIdx
+		case strings.HasPrefix(rest[i:], "::"):
+			// GADT 风格的种类签名
+			return typeParams
+		case isWordChar(rest[i]):
+			for i+1 < len(rest) && isWordChar(rest[i+1]) {
+				i++
+			}
+			end = i + 1
+		default:
+			continue
+		}
+		if fields := strings.Fields(rest[start:end]); len(fields) > 0 {
+			if c := fields[0][0]; (c >= 'a' && c <= 'z') || c == '_' {
+				typeParams = append(typeParams, models.TypeParam{Name: fields[0]})
+			}
+		}
+	}
+	return typeParams
+}
+
+// findOperator 查找 [start, end) 区间内不在括号中的独立运算符（如 = 而不是 == 或 =>）
+func (h *HaskellExtractor) findOperator(masked string, start, end int, operator string) int {
+	for i := start; i < end; i++ {
+		switch masked[i] {
+		case '(', '[', '{':
+			if closeIdx := matchBrace(masked, i); closeIdx > i && closeIdx < end {
+				i = closeIdx
+			}
+		default:
+			if h.isOperatorAt(masked, i, operator) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// isOperatorAt 检查 i 处是否为独立的运算符（前后不是其他运算符字符）
+func (h *HaskellExtractor) isOperatorAt(masked string, i int, operator string) bool {
+	if !strings.HasPrefix(masked[i:], operator) {
+		return false
+	}
+	if i > 0 && strings.IndexByte(haskellOperatorChars, masked[i-1]) >= 0 {
+		return false
+	}
+	next := i + len(operator)
+	return next >= len(masked) || strings.IndexByte(haskellOperatorChars, masked[next]) < 0
+}
+
+// haddock 提取声明上方的 -- | 或 {-| -} 注释，没有时使用声明末尾的 -- ^ 注释
+func (h *HaskellExtractor) haddock(lines []string, startLine, endLine int) string {
+	if comment := h.haddockAbove(lines, startLine); comment != "" {
+		return comment
+	}
+
+	for _, line := range []int{endLine, endLine + 1} {
+		if line < 1 || line > len(lines) {
+			continue
+		}
+		if idx := strings.Index(lines[line-1], "-- ^"); idx >= 0 {
+			if line == endLine || strings.HasPrefix(strings.TrimSpace(lines[line-1]), "-- ^") {
+				return strings.TrimSpace(lines[line-1][idx+len("-- ^"):])
+			}
+		}
+	}
+	return ""
+}
+
+// haddockAbove 提取指定行上方紧邻的 -- | 注释块或 {-| -} 块注释
+func (h *HaskellExtractor) haddockAbove(lines []string, line int) string {
+	var commentLines []string
+	i := line - 2
+	if i >= 0 && i < len(lines) && strings.HasSuffix(strings.TrimSpace(lines[i]), "-}") {
+		for ; i >= 0; i-- {
+			trimmed := strings.TrimSpace(lines[i])
+			start := strings.HasPrefix(trimmed, "{-")
+			trimmed = strings.TrimSuffix(strings.TrimPrefix(trimmed, "{-"), "-}")
+			if start && !strings.HasPrefix(trimmed, "|") {
+				return ""
+			}
+			if trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "|")); trimmed != "" {
+				commentLines = append([]string{trimmed}, commentLines...)
+			}
+			if start {
+				return strings.Join(commentLines, " ")
+			}
+		}
+		return ""
+	}
+
+	for ; i >= 0 && i < len(lines); i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, "--") {
+			break
+		}
+		comment := strings.TrimSpace(strings.TrimLeft(trimmed, "-"))
+		haddock := strings.HasPrefix(comment, "|")
+		if comment = strings.TrimSpace(strings.TrimPrefix(comment, "|")); comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+		if haddock {
+			return strings.Join(commentLines, " ")
+		}
+	}
+	return ""
+}
+
+// isWordChar 检查字符是否可以组成标识符
+func isWordChar(c byte) bool {
+	return c == '_' || c == '\'' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
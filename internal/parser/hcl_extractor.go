@@ -0,0 +1,260 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// hclChildAttributes 各类 Terraform 块中作为子符号输出的属性
+var hclChildAttributes = map[string][]string{
+	"variable": {"type", "default", "sensitive"},
+	"module":   {"source", "version"},
+	"output":   {"value", "sensitive"},
+	"provider": {"alias", "region"},
+}
+
+// HCLExtractor Terraform/HCL 提取器
+type HCLExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewHCLExtractor 创建Terraform/HCL提取器
+func NewHCLExtractor() *HCLExtractor {
+	return &HCLExtractor{
+		queries: []string{
+			"(config_file (body (block) @symbol))",
+		},
+	}
+}
+
+// GetQueries 获取HCL的Tree-sitter查询规则
+func (h *HCLExtractor) GetQueries() []string {
+	return h.queries
+}
+
+// ExtractPrototype 提取块声明（如 resource "aws_s3_bucket" "logs"），不包含块体
+func (h *HCLExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	if node.Type() == "block" {
+		for i := 0; i < int(node.ChildCount()); i++ {
+			child := node.Child(i)
+			if child != nil && child.Type() == "block_start" {
+				return h.cleanText(string(content[node.StartByte():child.StartByte()]))
+			}
+		}
+	}
+
+	return h.extractFullNode(node, content)
+}
+
+// ExtractMethods HCL没有方法，返回空
+func (h *HCLExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	return []models.Symbol{}
+}
+
+// IsClassNode 检查是否是块节点
+func (h *HCLExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "block"
+}
+
+// IsFunctionBodyNode 检查是否是块体节点
+func (h *HCLExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "block_start"
+}
+
+// IsInsideClass 检查节点是否在其他块内部
+func (h *HCLExtractor) IsInsideClass(node *sitter.Node) bool {
+	current := node.Parent()
+	for current != nil {
+		if current.Type() == "block" {
+			return true
+		}
+		current = current.Parent()
+	}
+	return false
+}
+
+// ExtractComments 优先使用块的 description 属性，否则提取上方紧邻的 # 或 // 注释
+func (h *HCLExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	if description, ok := h.blockAttributes(node, content)["description"]; ok {
+		return h.unquote(description.NamedChild(1).Content(content))
+	}
+
+	startRow := int(node.StartPoint().Row)
+	lines := strings.Split(string(content), "\n")
+
+	var commentLines []string
+	for i := startRow - 1; i >= 0; i-- {
+		if i >= len(lines) {
+			continue
+		}
+
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			// 只取紧邻的注释，空行之前的注释（如文件头的许可证说明）不属于该块
+			break
+		}
+
+		var comment string
+		switch {
+		case strings.HasPrefix(line, "#"):
+			comment = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+		case strings.HasPrefix(line, "//"):
+			comment = strings.TrimSpace(strings.TrimPrefix(line, "//"))
+		default:
+			return strings.Join(commentLines, " ")
+		}
+
+		if comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// ExtractSymbols 提取顶层块：resource、data、module、variable、output、provider、locals 等
+func (h *HCLExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		body := root.NamedChild(i)
+		if body == nil || body.Type() != "body" {
+			continue
+		}
+
+		for j := 0; j < int(body.NamedChildCount()); j++ {
+			block := body.NamedChild(j)
+			if block == nil || block.Type() != "block" {
+				continue
+			}
+
+			symbol := h.createSymbol(block, content)
+			symbol.Children = h.extractBlockChildren(block, content)
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的 # 或 // 注释块作为文件用途
+// （紧贴第一个块的注释属于该块，不作为文件用途）
+func (h *HCLExtractor) ExtractFilePurpose(content []byte) string {
+	var commentLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if len(commentLines) > 0 {
+				break
+			}
+			continue
+		}
+
+		var comment string
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			comment = strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+		case strings.HasPrefix(trimmed, "//"):
+			comment = strings.TrimSpace(strings.TrimPrefix(trimmed, "//"))
+		default:
+			return ""
+		}
+
+		if comment != "" {
+			commentLines = append(commentLines, comment)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// createSymbol 创建块或属性符号
+func (h *HCLExtractor) createSymbol(node *sitter.Node, content []byte) models.Symbol {
+	return models.Symbol{
+		Prototype: h.ExtractPrototype(node, content),
+		Purpose:   h.ExtractComments(node, content),
+		Range:     []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+	}
+}
+
+// extractBlockChildren 按块类型提取子符号：变量的类型和默认值、模块来源、locals 中的各项等
+func (h *HCLExtractor) extractBlockChildren(block *sitter.Node, content []byte) []models.Symbol {
+	var children []models.Symbol
+	blockType := h.blockType(block, content)
+	attributes := h.blockAttributes(block, content)
+
+	switch blockType {
+	case "locals", "terraform":
+		// locals 的每一项、terraform 的设置（如 required_providers、backend）全部列出
+		body := h.blockBody(block)
+		for i := 0; body != nil && i < int(body.NamedChildCount()); i++ {
+			child := body.NamedChild(i)
+			if child != nil && (child.Type() == "attribute" || child.Type() == "block") {
+				children = append(children, h.createSymbol(child, content))
+			}
+		}
+	default:
+		for _, name := range hclChildAttributes[blockType] {
+			if attribute, ok := attributes[name]; ok {
+				children = append(children, h.createSymbol(attribute, content))
+			}
+		}
+	}
+
+	return children
+}
+
+// blockType 获取块类型（第一个标识符）
+func (h *HCLExtractor) blockType(block *sitter.Node, content []byte) string {
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+		if child != nil && child.Type() == "identifier" {
+			return child.Content(content)
+		}
+	}
+	return ""
+}
+
+// blockBody 获取块体节点
+func (h *HCLExtractor) blockBody(block *sitter.Node) *sitter.Node {
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+		if child != nil && child.Type() == "body" {
+			return child
+		}
+	}
+	return nil
+}
+
+// blockAttributes 获取块体中直接定义的属性，返回属性名到属性节点的映射
+func (h *HCLExtractor) blockAttributes(block *sitter.Node, content []byte) map[string]*sitter.Node {
+	attributes := make(map[string]*sitter.Node)
+
+	body := h.blockBody(block)
+	if body == nil {
+		return attributes
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		attribute := body.NamedChild(i)
+		if attribute == nil || attribute.Type() != "attribute" || attribute.NamedChildCount() < 2 {
+			continue
+		}
+		name := attribute.NamedChild(0).Content(content)
+		attributes[name] = attribute
+	}
+
+	return attributes
+}
+
+// unquote 去除字符串字面量两端的引号
+func (h *HCLExtractor) unquote(text string) string {
+	text = strings.TrimSpace(text)
+	if len(text) >= 2 && strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) {
+		return text[1 : len(text)-1]
+	}
+	return h.cleanText(text)
+}
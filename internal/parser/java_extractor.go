@@ -7,6 +7,24 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+// javaTypeDeclarations 可以包含成员的类型声明
+var javaTypeDeclarations = map[string]bool{
+	"class_declaration":           true,
+	"interface_declaration":       true,
+	"enum_declaration":            true,
+	"record_declaration":          true,
+	"annotation_type_declaration": true,
+}
+
+// javaModifierKeywords 记录为修饰符的关键字（可见性关键字单独记录在 visibility 字段中）
+var javaModifierKeywords = map[string]bool{
+	"static": true, "abstract": true, "final": true, "synchronized": true, "native": true, "default": true,
+	"sealed": true, "non-sealed": true, "transient": true, "volatile": true, "strictfp": true,
+}
+
+// javaVisibilityKeywords 可见性关键字
+var javaVisibilityKeywords = map[string]bool{"public": true, "protected": true, "private": true}
+
 // JavaExtractor Java语言提取器
 type JavaExtractor struct {
 	BaseExtractor
@@ -33,19 +51,29 @@ func (j *JavaExtractor) GetQueries() []string {
 
 // ExtractPrototype 提取Java类原型
 func (j *JavaExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
-	nodeType := node.Type()
+	switch node.Type() {
+	case "class_declaration", "interface_declaration", "enum_declaration", "record_declaration", "annotation_type_declaration":
+		// 对于类型声明，只提取声明部分（不包含类体）
+		if body := node.ChildByFieldName("body"); body != nil {
+			return j.cleanText(string(content[node.StartByte():body.StartByte()]))
+		}
 
-	// 对于类声明，只提取类声明部分（不包含类体）
-	if nodeType == "class_declaration" {
-		return j.extractClassPrototype(node, content)
-	}
+	case "method_declaration", "constructor_declaration", "compact_constructor_declaration":
+		// 对于方法声明，提取方法签名
+		return strings.TrimSuffix(j.extractMethodPrototype(node, content), ";")
+
+	case "field_declaration", "constant_declaration":
+		// 字段的初始值可能很长，超出部分截断
+		return truncateConfigValue(strings.TrimSuffix(j.extractFullNode(node, content), ";"))
 
-	// 对于方法声明，提取方法签名
-	if nodeType == "method_declaration" {
-		return j.extractMethodPrototype(node, content)
+	case "enum_constant":
+		// 枚举常量只保留名称和构造参数，不包含常量体
+		if body := node.ChildByFieldName("body"); body != nil {
+			return j.cleanText(string(content[node.StartByte():body.StartByte()]))
+		}
 	}
 
-	return j.extractFullNode(node, content)
+	return strings.TrimSuffix(j.extractFullNode(node, content), ";")
 }
 
 // ExtractMethods 提取Java类内部的方法
@@ -110,6 +138,190 @@ func (j *JavaExtractor) ExtractComments(node *sitter.Node, content []byte) strin
 	return extractJavaCommentsFixed(node, content)
 }
 
+// ExtractSymbols 提取顶层类型声明：方法和构造函数放入 Methods，字段、常量、枚举常量以及
+// 静态嵌套类和内部类放入 Children，成员上的注解记录在 annotations 字段中
+func (j *JavaExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		if child := root.NamedChild(i); javaTypeDeclarations[child.Type()] {
+			symbols = append(symbols, j.createTypeSymbol(child, content))
+		}
+	}
+	return symbols
+}
+
+// createTypeSymbol 创建类、接口、枚举、记录或注解类型符号，递归提取嵌套类型
+func (j *JavaExtractor) createTypeSymbol(node *sitter.Node, content []byte) models.Symbol {
+	symbol := j.createMemberSymbol(node, content)
+
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		return symbol
+	}
+	j.extractMembers(body, content, &symbol)
+
+	// 接口和注解类型的成员隐式为 public
+	if node.Type() == "interface_declaration" || node.Type() == "annotation_type_declaration" {
+		for _, members := range [][]models.Symbol{symbol.Methods, symbol.Children} {
+			for i := range members {
+				if members[i].Visibility == visibilityPackage {
+					members[i].Visibility = visibilityPublic
+				}
+			}
+		}
+	}
+	return symbol
+}
+
+// extractMembers 提取类体中的成员，枚举体中常量之后的声明位于 enum_body_declarations 中
+func (j *JavaExtractor) extractMembers(body *sitter.Node, content []byte, symbol *models.Symbol) {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+
+		switch member.Type() {
+		case "method_declaration", "constructor_declaration", "compact_constructor_declaration", "annotation_type_element_declaration":
+			method := j.createMemberSymbol(member, content)
+			// 枚举的构造函数只能是 private，省略修饰符时也是如此
+			if member.Type() == "constructor_declaration" && method.Visibility == visibilityPackage &&
+				(body.Type() == "enum_body" || body.Type() == "enum_body_declarations") {
+				method.Visibility = visibilityPrivate
+			}
+			symbol.Methods = append(symbol.Methods, method)
+
+		case "field_declaration", "constant_declaration", "enum_constant":
+			symbol.Children = append(symbol.Children, j.createMemberSymbol(member, content))
+
+		case "enum_body_declarations":
+			j.extractMembers(member, content, symbol)
+
+		default:
+			if javaTypeDeclarations[member.Type()] {
+				symbol.Children = append(symbol.Children, j.createTypeSymbol(member, content))
+			}
+		}
+	}
+}
+
+// createMemberSymbol 创建成员符号并记录其注解
+func (j *JavaExtractor) createMemberSymbol(node *sitter.Node, content []byte) models.Symbol {
+	start := node.StartPoint()
+	end := node.EndPoint()
+
+	symbol := models.Symbol{
+		Prototype:   j.ExtractPrototype(node, content),
+		Purpose:     extractJavaCommentsFixed(node, content),
+		Range:       []int{int(start.Row) + 1, int(end.Row) + 1},
+		Annotations: j.extractAnnotations(node, content),
+	}
+	symbol.Visibility, symbol.Modifiers = j.extractModifiers(node, content)
+	symbol.TypeParams = j.extractTypeParams(node.ChildByFieldName("type_parameters"), content)
+	symbol.Params = j.extractParams(node.ChildByFieldName("parameters"), content)
+	if node.Type() == "method_declaration" || node.Type() == "annotation_type_element_declaration" {
+		if returnType := fieldText(node, "type", content); returnType != "void" {
+			symbol.Returns = []string{returnType}
+		}
+	}
+	return symbol
+}
+
+// extractParams 解析方法、构造函数的参数和记录的组件，Type... 形式的参数标记为可变参数
+func (j *JavaExtractor) extractParams(list *sitter.Node, content []byte) []models.Param {
+	if list == nil {
+		return nil
+	}
+
+	var params []models.Param
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		node := list.NamedChild(i)
+		switch node.Type() {
+		case "formal_parameter":
+			params = append(params, models.Param{Name: fieldText(node, "name", content), Type: fieldText(node, "type", content)})
+		case "spread_parameter":
+			// spread_parameter 的类型和名称没有字段名：类型在 modifiers 之后，名称在 variable_declarator 中
+			param := models.Param{Variadic: true}
+			for k := 0; k < int(node.NamedChildCount()); k++ {
+				switch child := node.NamedChild(k); child.Type() {
+				case "modifiers":
+				case "variable_declarator":
+					param.Name = fieldText(child, "name", content)
+				default:
+					if param.Type == "" {
+						param.Type = nodeText(child, content)
+					}
+				}
+			}
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// extractTypeParams 解析类型参数，T extends A & B 的上界作为约束
+func (j *JavaExtractor) extractTypeParams(list *sitter.Node, content []byte) []models.TypeParam {
+	if list == nil {
+		return nil
+	}
+
+	var typeParams []models.TypeParam
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		node := list.NamedChild(i)
+		var typeParam models.TypeParam
+		for k := 0; k < int(node.NamedChildCount()); k++ {
+			switch child := node.NamedChild(k); child.Type() {
+			case "type_identifier", "identifier":
+				typeParam.Name = nodeText(child, content)
+			case "type_bound":
+				typeParam.Constraint = strings.TrimSpace(strings.TrimPrefix(nodeText(child, content), "extends"))
+			}
+		}
+		typeParams = append(typeParams, typeParam)
+	}
+	return typeParams
+}
+
+// extractModifiers 从声明修饰符中提取可见性和其他修饰符，没有可见性关键字时为包内可见；枚举常量总是 public
+func (j *JavaExtractor) extractModifiers(node *sitter.Node, content []byte) (string, []string) {
+	if node.Type() == "enum_constant" {
+		return visibilityPublic, nil
+	}
+
+	visibility := visibilityPackage
+	var modifiers []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "modifiers" {
+			continue
+		}
+		for k := 0; k < int(child.ChildCount()); k++ {
+			word := child.Child(k).Content(content)
+			switch {
+			case javaVisibilityKeywords[word]:
+				visibility = word
+			case javaModifierKeywords[word]:
+				modifiers = appendModifier(modifiers, word)
+			}
+		}
+	}
+	return visibility, modifiers
+}
+
+// extractAnnotations 提取声明修饰符中的注解，如 @Override、@GetMapping("/x")
+func (j *JavaExtractor) extractAnnotations(node *sitter.Node, content []byte) []string {
+	var annotations []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		modifiers := node.NamedChild(i)
+		if modifiers.Type() != "modifiers" {
+			continue
+		}
+		for k := 0; k < int(modifiers.NamedChildCount()); k++ {
+			if annotation := modifiers.NamedChild(k); annotation.Type() == "annotation" || annotation.Type() == "marker_annotation" {
+				annotations = append(annotations, j.cleanText(annotation.Content(content)))
+			}
+		}
+	}
+	return annotations
+}
+
 // extractClassPrototype 提取Java类原型
 func (j *JavaExtractor) extractClassPrototype(node *sitter.Node, content []byte) string {
 	childCount := int(node.ChildCount())
@@ -193,6 +405,18 @@ func extractJavaCommentsFixed(node *sitter.Node, content []byte) string {
 
 		// 检查Javadoc注释结束
 		if strings.HasSuffix(line, "*/") {
+			// 单行的 /** ... */ 注释
+			if strings.HasPrefix(line, "/*") {
+				comment := strings.TrimSpace(strings.TrimLeft(strings.TrimSuffix(line, "*/"), "/*"))
+				if comment != "" {
+					commentLines = append([]string{comment}, commentLines...)
+				}
+				break
+			}
+			// 代码行末尾的注释属于该行代码
+			if strings.Contains(line, "/*") {
+				break
+			}
 			inMultiLineComment = true
 			comment := strings.TrimSpace(strings.TrimSuffix(line, "*/"))
 			if comment != "" {
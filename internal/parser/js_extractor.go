@@ -7,6 +7,27 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+const (
+	jsExportDefault = "default"
+	jsExportNamed   = "named"
+)
+
+// maxTypePrototypeLength 接口和类型别名原型中成员签名的最大字符数，超出的成员省略为 ...
+const maxTypePrototypeLength = 160
+
+// jsFunctionValues 可以作为变量值或对象属性值的函数表达式节点
+var jsFunctionValues = map[string]bool{
+	"arrow_function":      true,
+	"function_expression": true,
+	"function":            true,
+	"generator_function":  true,
+}
+
+// jsModifierKeywords 声明和类成员上记录为修饰符的关键字（访问修饰符单独记录在 visibility 字段中）
+var jsModifierKeywords = map[string]bool{
+	"async": true, "static": true, "abstract": true, "readonly": true, "override": true, "declare": true,
+}
+
 // JSExtractor JavaScript/TypeScript语言提取器
 type JSExtractor struct {
 	BaseExtractor
@@ -35,16 +56,23 @@ func (j *JSExtractor) ExtractPrototype(node *sitter.Node, content []byte) string
 	nodeType := node.Type()
 
 	// 对于类声明，只提取类声明部分（不包含类体）
-	if nodeType == "class_declaration" {
+	if nodeType == "class_declaration" || nodeType == "abstract_class_declaration" || nodeType == "class" {
 		return j.extractClassPrototype(node, content)
 	}
 
 	// 对于函数定义，提取函数签名
-	if nodeType == "function_declaration" || nodeType == "method_definition" {
-		return j.extractFunctionPrototype(node, content, j.IsFunctionBodyNode)
+	if nodeType == "function_declaration" || nodeType == "generator_function_declaration" ||
+		nodeType == "method_definition" || jsFunctionValues[nodeType] {
+		return j.functionHead(node, content)
+	}
+
+	// 接口和对象类型别名只保留成员签名，避免类型体和注释撑大原型
+	if nodeType == "interface_declaration" || nodeType == "type_alias_declaration" {
+		return j.typePrototype(node, content)
 	}
 
-	return j.extractFullNode(node, content)
+	// 枚举和函数重载签名保留完整声明
+	return strings.TrimSuffix(j.extractFullNode(node, content), ";")
 }
 
 // ExtractMethods 提取JS/TS类内部的方法
@@ -70,8 +98,9 @@ func (j *JSExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []m
 				}
 
 				bodyChildType := bodyChild.Type()
-				if bodyChildType == "method_definition" {
+				if bodyChildType == "method_definition" || bodyChildType == "abstract_method_signature" {
 					method := j.createMethodSymbol(bodyChild, content)
+					method.Visibility = j.memberVisibility(bodyChild, content)
 					methods = append(methods, method)
 				}
 			}
@@ -83,7 +112,7 @@ func (j *JSExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []m
 
 // IsClassNode 检查是否是类节点
 func (j *JSExtractor) IsClassNode(nodeType string) bool {
-	return nodeType == "class_declaration"
+	return nodeType == "class_declaration" || nodeType == "abstract_class_declaration"
 }
 
 // IsFunctionBodyNode 检查是否是函数体节点
@@ -106,7 +135,425 @@ func (j *JSExtractor) IsInsideClass(node *sitter.Node) bool {
 
 // ExtractComments 提取JS/TS注释
 func (j *JSExtractor) ExtractComments(node *sitter.Node, content []byte) string {
-	return j.extractJSComments(node, content)
+	purpose, _ := j.extractDoc(node, content)
+	return purpose
+}
+
+// ExtractSymbols 遍历顶层语句提取函数、类、接口、类型、赋值为函数或对象的变量、导出的常量，
+// 以及 CommonJS 的 module.exports / exports.x 赋值，并记录每个符号的导出方式
+func (j *JSExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	return j.extractStatements(root, content)
+}
+
+// jsScope 一组语句中已提取的符号以及按名称引用的导出
+type jsScope struct {
+	symbols []models.Symbol
+	hidden  []bool         // 只有被导出时才输出的符号（普通常量）
+	names   map[string]int // 名称到符号位置的映射
+	exports map[string]string
+}
+
+// add 添加符号，name 非空时记录名称以便之后的导出语句引用
+func (s *jsScope) add(name string, symbol models.Symbol, hidden bool) {
+	if name != "" {
+		s.names[name] = len(s.symbols)
+	}
+	s.symbols = append(s.symbols, symbol)
+	s.hidden = append(s.hidden, hidden)
+}
+
+// extractStatements 提取一组语句（程序或命名空间体）中的符号
+func (j *JSExtractor) extractStatements(parent *sitter.Node, content []byte) []models.Symbol {
+	scope := &jsScope{names: make(map[string]int), exports: make(map[string]string)}
+
+	for i := 0; i < int(parent.NamedChildCount()); i++ {
+		node := parent.NamedChild(i)
+
+		switch node.Type() {
+		case "export_statement":
+			j.extractExportStatement(scope, node, content)
+		case "expression_statement":
+			j.extractCommonJSExport(scope, node, content)
+		case "ambient_declaration":
+			if node.NamedChildCount() > 0 {
+				j.extractDeclaration(scope, node.NamedChild(0), node, "", content)
+			}
+		default:
+			j.extractDeclaration(scope, node, node, "", content)
+		}
+	}
+
+	// 按名称导出的符号（export { a }、export default a、module.exports = { a }）
+	for name, export := range scope.exports {
+		if index, ok := scope.names[name]; ok {
+			scope.symbols[index].Export = export
+			scope.hidden[index] = false
+		}
+	}
+
+	// 有导出的文件是模块，未导出的符号只在模块内可见；没有任何导出的脚本中的符号是全局的，不记录可见性
+	isModule := false
+	for _, symbol := range scope.symbols {
+		if symbol.Export != "" {
+			isModule = true
+		}
+	}
+
+	var symbols []models.Symbol
+	for i, symbol := range scope.symbols {
+		if scope.hidden[i] {
+			continue
+		}
+		if symbol.Export != "" {
+			symbol.Visibility = visibilityExported
+		} else if isModule {
+			symbol.Visibility = visibilityPrivate
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// extractExportStatement 处理 export 声明、export default 表达式和 export { ... } 列表
+func (j *JSExtractor) extractExportStatement(scope *jsScope, node *sitter.Node, content []byte) {
+	export := jsExportNamed
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).Type() == "default" {
+			export = jsExportDefault
+		}
+	}
+
+	if declaration := node.ChildByFieldName("declaration"); declaration != nil {
+		j.extractDeclaration(scope, declaration, node, export, content)
+		return
+	}
+
+	if value := node.ChildByFieldName("value"); value != nil {
+		if value.Type() == "identifier" {
+			scope.exports[value.Content(content)] = jsExportDefault
+			return
+		}
+		if symbol, ok := j.createValueSymbol("export default", value, node, content); ok {
+			j.annotateReactSymbol(&symbol, "", value, nil, content)
+			symbol.Export = jsExportDefault
+			scope.add("", symbol, false)
+		}
+		return
+	}
+
+	// 重新导出其他模块（export { a } from "./a"）不对应本文件中的符号
+	if node.ChildByFieldName("source") != nil {
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		clause := node.NamedChild(i)
+		if clause.Type() != "export_clause" {
+			continue
+		}
+		for k := 0; k < int(clause.NamedChildCount()); k++ {
+			specifier := clause.NamedChild(k)
+			name := specifier.ChildByFieldName("name")
+			if name == nil {
+				continue
+			}
+			export := jsExportNamed
+			if alias := specifier.ChildByFieldName("alias"); alias != nil && alias.Content(content) == "default" {
+				export = jsExportDefault
+			}
+			scope.exports[name.Content(content)] = export
+		}
+	}
+}
+
+// extractDeclaration 提取声明语句，outer 为包含注释和完整范围的外层语句（如 export 语句）
+func (j *JSExtractor) extractDeclaration(scope *jsScope, node, outer *sitter.Node, export string, content []byte) {
+	name := ""
+	if nameNode := node.ChildByFieldName("name"); nameNode != nil {
+		name = nameNode.Content(content)
+	}
+
+	switch node.Type() {
+	case "function_declaration", "generator_function_declaration", "function_signature",
+		"class_declaration", "abstract_class_declaration",
+		"interface_declaration", "type_alias_declaration", "enum_declaration":
+		symbol := j.createSymbol(outer, j.ExtractPrototype(node, content), content)
+		symbol.Modifiers = j.extractModifiers(node, outer, content)
+		symbol.TypeParams, symbol.Params, symbol.Returns = j.extractSignature(node, content)
+		if j.IsClassNode(node.Type()) {
+			symbol.Methods = j.ExtractMethods(node, content)
+		}
+		j.annotateReactSymbol(&symbol, name, node, nil, content)
+		symbol.Export = export
+		scope.add(name, symbol, false)
+
+	case "internal_module", "module":
+		// TypeScript 命名空间和 declare module
+		symbol := j.createSymbol(outer, j.cleanText(string(content[node.StartByte():j.bodyStart(node)])), content)
+		symbol.Modifiers = j.extractModifiers(node, outer, content)
+		if body := node.ChildByFieldName("body"); body != nil {
+			symbol.Children = j.extractStatements(body, content)
+		}
+		symbol.Export = export
+		scope.add(name, symbol, false)
+
+	case "lexical_declaration", "variable_declaration":
+		keyword := node.Child(0).Content(content)
+		declarators := int(node.NamedChildCount())
+		for i := 0; i < declarators; i++ {
+			declarator := node.NamedChild(i)
+			nameNode := declarator.ChildByFieldName("name")
+			value := declarator.ChildByFieldName("value")
+			if declarator.Type() != "variable_declarator" || nameNode == nil || value == nil {
+				continue
+			}
+
+			// 只有一个变量时使用外层语句的范围和注释
+			rangeNode := declarator
+			if declarators == 1 {
+				rangeNode = outer
+			}
+			head := keyword + " " + j.cleanText(string(content[declarator.StartByte():value.StartByte()]))
+			symbol, ok := j.createValueSymbol(head, value, rangeNode, content)
+			hidden := false
+			if ok {
+				j.annotateReactSymbol(&symbol, nameNode.Content(content), value, declarator.ChildByFieldName("type"), content)
+			} else {
+				// 普通常量只有被导出时才输出
+				symbol = j.createSymbol(rangeNode, keyword+" "+truncateConfigValue(j.cleanText(declarator.Content(content))), content)
+				hidden = export == ""
+			}
+			symbol.Export = export
+			scope.add(nameNode.Content(content), symbol, hidden)
+		}
+	}
+}
+
+// extractCommonJSExport 处理 module.exports = ...、exports.x = ... 和 module.exports.x = ... 赋值
+func (j *JSExtractor) extractCommonJSExport(scope *jsScope, node *sitter.Node, content []byte) {
+	if node.NamedChildCount() != 1 || node.NamedChild(0).Type() != "assignment_expression" {
+		return
+	}
+	assignment := node.NamedChild(0)
+	left := assignment.ChildByFieldName("left")
+	right := assignment.ChildByFieldName("right")
+	if left == nil || right == nil {
+		return
+	}
+
+	target := left.Content(content)
+	head := j.cleanText(string(content[left.StartByte():right.StartByte()]))
+	switch {
+	case target == "module.exports":
+		if right.Type() == "identifier" {
+			scope.exports[right.Content(content)] = jsExportDefault
+			return
+		}
+		if right.Type() == "object" {
+			// 简写属性和以标识符为值的属性引用了本文件中的符号
+			for i := 0; i < int(right.NamedChildCount()); i++ {
+				member := right.NamedChild(i)
+				switch member.Type() {
+				case "shorthand_property_identifier":
+					scope.exports[member.Content(content)] = jsExportNamed
+				case "pair":
+					if value := member.ChildByFieldName("value"); value != nil && value.Type() == "identifier" {
+						scope.exports[value.Content(content)] = jsExportNamed
+					}
+				}
+			}
+		}
+		if symbol, ok := j.createValueSymbol(head, right, node, content); ok {
+			j.annotateReactSymbol(&symbol, "", right, nil, content)
+			symbol.Export = jsExportDefault
+			scope.add("", symbol, false)
+		}
+
+	case strings.HasPrefix(target, "exports.") || strings.HasPrefix(target, "module.exports."):
+		if right.Type() == "identifier" {
+			scope.exports[right.Content(content)] = jsExportNamed
+			return
+		}
+		symbol, ok := j.createValueSymbol(head, right, node, content)
+		if ok {
+			j.annotateReactSymbol(&symbol, target[strings.LastIndex(target, ".")+1:], right, nil, content)
+		} else {
+			symbol = j.createSymbol(node, truncateConfigValue(j.cleanText(assignment.Content(content))), content)
+		}
+		symbol.Export = jsExportNamed
+		scope.add("", symbol, false)
+	}
+}
+
+// createValueSymbol 为赋值给变量或导出的函数、类、React 包装组件和包含函数的对象字面量创建符号，
+// head 为值之前的部分（如 const foo =、module.exports =）
+func (j *JSExtractor) createValueSymbol(head string, value, rangeNode *sitter.Node, content []byte) (models.Symbol, bool) {
+	head = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(head), "="))
+	if head != "export default" {
+		head += " ="
+	}
+
+	switch {
+	case jsFunctionValues[value.Type()]:
+		symbol := j.createSymbol(rangeNode, head+" "+j.functionHead(value, content), content)
+		symbol.Modifiers = keywordModifiers(value, content, jsModifierKeywords)
+		symbol.TypeParams, symbol.Params, symbol.Returns = j.extractSignature(value, content)
+		return symbol, true
+
+	case value.Type() == "class":
+		symbol := j.createSymbol(rangeNode, head+" "+j.extractClassPrototype(value, content), content)
+		symbol.TypeParams, _, _ = j.extractSignature(value, content)
+		symbol.Methods = j.ExtractMethods(value, content)
+		return symbol, true
+
+	case value.Type() == "call_expression":
+		// memo(...)、forwardRef(...) 包装的组件
+		fn, _ := j.unwrapReactComponent(value, content)
+		if fn == nil {
+			return models.Symbol{}, false
+		}
+		return j.createSymbol(rangeNode, head+" "+j.cleanText(string(content[value.StartByte():j.bodyStart(fn)])), content), true
+
+	case value.Type() == "object":
+		methods := j.extractObjectMethods(value, content)
+		if len(methods) == 0 && !strings.HasPrefix(head, "module.exports") && head != "export default" {
+			return models.Symbol{}, false
+		}
+		symbol := j.createSymbol(rangeNode, head+" "+j.objectPrototype(value, content), content)
+		symbol.Methods = methods
+		return symbol, true
+	}
+
+	return models.Symbol{}, false
+}
+
+// extractObjectMethods 提取对象字面量中的方法和以函数为值的属性
+func (j *JSExtractor) extractObjectMethods(object *sitter.Node, content []byte) []models.Symbol {
+	var methods []models.Symbol
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		member := object.NamedChild(i)
+		switch member.Type() {
+		case "method_definition":
+			methods = append(methods, j.createMethodSymbol(member, content))
+		case "pair":
+			key := member.ChildByFieldName("key")
+			value := member.ChildByFieldName("value")
+			if key != nil && value != nil && jsFunctionValues[value.Type()] {
+				method := j.createSymbol(member, key.Content(content)+": "+j.functionHead(value, content), content)
+				method.TypeParams, method.Params, method.Returns = j.extractSignature(value, content)
+				methods = append(methods, method)
+			}
+		}
+	}
+	return methods
+}
+
+// objectPrototype 生成对象字面量的原型，只保留属性名，如 { get, post, baseURL }
+func (j *JSExtractor) objectPrototype(object *sitter.Node, content []byte) string {
+	var keys []string
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		member := object.NamedChild(i)
+		switch member.Type() {
+		case "shorthand_property_identifier":
+			keys = append(keys, member.Content(content))
+		case "pair", "method_definition":
+			field := "key"
+			if member.Type() == "method_definition" {
+				field = "name"
+			}
+			if key := member.ChildByFieldName(field); key != nil {
+				keys = append(keys, key.Content(content))
+			}
+		case "spread_element":
+			keys = append(keys, j.cleanText(member.Content(content)))
+		}
+	}
+	if len(keys) == 0 {
+		return "{}"
+	}
+	return "{ " + strings.Join(keys, ", ") + " }"
+}
+
+// typePrototype 生成接口或类型别名的原型：声明头加成员签名，去掉注释，超出长度的成员省略为 ...
+func (j *JSExtractor) typePrototype(node *sitter.Node, content []byte) string {
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		body = node.ChildByFieldName("value")
+	}
+	if body == nil {
+		return strings.TrimSuffix(j.extractFullNode(node, content), ";")
+	}
+	head := j.cleanText(string(content[node.StartByte():body.StartByte()]))
+	if body.Type() != "object_type" && body.Type() != "interface_body" {
+		// 多行联合类型开头的 | 只是排版用的
+		value := strings.TrimPrefix(j.withoutComments(body, content), "| ")
+		return head + " " + truncateRunes(value, maxTypePrototypeLength)
+	}
+
+	var members []string
+	length := 0
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		if member.Type() == "comment" {
+			continue
+		}
+		text := strings.TrimRight(j.withoutComments(member, content), ";,")
+		if len(members) > 0 && length+len(text) > maxTypePrototypeLength {
+			members = append(members, "...")
+			break
+		}
+		members = append(members, truncateRunes(text, maxTypePrototypeLength))
+		length += len(text)
+	}
+	if len(members) == 0 {
+		return head + " {}"
+	}
+	return head + " { " + strings.Join(members, "; ") + " }"
+}
+
+// withoutComments 返回去掉注释并压缩空白后的节点文本
+func (j *JSExtractor) withoutComments(node *sitter.Node, content []byte) string {
+	var text strings.Builder
+	pos := node.StartByte()
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			child := n.NamedChild(i)
+			if child.Type() == "comment" {
+				text.Write(content[pos:child.StartByte()])
+				text.WriteString(" ")
+				pos = child.EndByte()
+				continue
+			}
+			walk(child)
+		}
+	}
+	walk(node)
+	text.Write(content[pos:node.EndByte()])
+	return j.cleanText(text.String())
+}
+
+// createSymbol 创建以节点范围为行号范围的符号，说明来自节点上方的注释
+func (j *JSExtractor) createSymbol(node *sitter.Node, prototype string, content []byte) models.Symbol {
+	purpose, doc := j.extractDoc(node, content)
+	return models.Symbol{
+		Prototype: prototype,
+		Purpose:   purpose,
+		Doc:       doc,
+		Range:     []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+	}
+}
+
+// functionHead 提取函数签名（函数体之前的部分，箭头函数包含 =>）
+func (j *JSExtractor) functionHead(node *sitter.Node, content []byte) string {
+	return j.cleanText(string(content[node.StartByte():j.bodyStart(node)]))
+}
+
+// bodyStart 返回节点 body 字段的起始位置，没有 body 时返回节点结束位置
+func (j *JSExtractor) bodyStart(node *sitter.Node) uint32 {
+	if body := node.ChildByFieldName("body"); body != nil {
+		return body.StartByte()
+	}
+	return node.EndByte()
 }
 
 // extractClassPrototype 提取JS/TS类原型
@@ -130,16 +577,181 @@ func (j *JSExtractor) extractClassPrototype(node *sitter.Node, content []byte) s
 
 // createMethodSymbol 创建方法符号
 func (j *JSExtractor) createMethodSymbol(node *sitter.Node, content []byte) models.Symbol {
-	start := node.StartPoint()
-	end := node.EndPoint()
+	symbol := j.createSymbol(node, j.ExtractPrototype(node, content), content)
+	symbol.Modifiers = j.extractModifiers(node, node, content)
+	symbol.TypeParams, symbol.Params, symbol.Returns = j.extractSignature(node, content)
+	return symbol
+}
 
-	prototype := j.extractFunctionPrototype(node, content, j.IsFunctionBodyNode)
+// extractSignature 提取函数、方法和类型声明的类型参数、参数列表和返回类型（去掉类型注解的冒号，void 视为没有返回值）
+func (j *JSExtractor) extractSignature(node *sitter.Node, content []byte) ([]models.TypeParam, []models.Param, []string) {
+	var typeParams []models.TypeParam
+	if list := node.ChildByFieldName("type_parameters"); list != nil {
+		for i := 0; i < int(list.NamedChildCount()); i++ {
+			typeParam := list.NamedChild(i)
+			typeParams = append(typeParams, models.TypeParam{
+				Name:       fieldText(typeParam, "name", content),
+				Constraint: strings.TrimSpace(strings.TrimPrefix(fieldText(typeParam, "constraint", content), "extends")),
+				Default:    strings.TrimSpace(strings.TrimPrefix(fieldText(typeParam, "value", content), "=")),
+			})
+		}
+	}
 
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   j.extractJSComments(node, content),
-		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
+	var params []models.Param
+	if list := node.ChildByFieldName("parameters"); list != nil {
+		for i := 0; i < int(list.NamedChildCount()); i++ {
+			if param, ok := j.parameter(list.NamedChild(i), content); ok {
+				params = append(params, param)
+			}
+		}
+	} else if param := node.ChildByFieldName("parameter"); param != nil {
+		// 省略括号的箭头函数 x => ...
+		params = []models.Param{{Name: param.Content(content)}}
+	}
+
+	var returns []string
+	if returnType := j.typeAnnotation(node.ChildByFieldName("return_type"), content); returnType != "" && returnType != "void" {
+		returns = []string{returnType}
+	}
+	return typeParams, params, returns
+}
+
+// parameter 解析单个参数：TypeScript 的 required_parameter/optional_parameter，
+// 以及 JavaScript 的标识符、解构模式、默认值（a = 1）和剩余参数（...rest）
+func (j *JSExtractor) parameter(node *sitter.Node, content []byte) (models.Param, bool) {
+	var param models.Param
+	pattern := node
+	switch node.Type() {
+	case "required_parameter", "optional_parameter":
+		pattern = node.ChildByFieldName("pattern")
+		param.Type = j.typeAnnotation(node.ChildByFieldName("type"), content)
+		param.Default = fieldText(node, "value", content)
+	case "assignment_pattern":
+		pattern = node.ChildByFieldName("left")
+		param.Default = fieldText(node, "right", content)
+	case "comment":
+		return param, false
+	}
+	if pattern == nil {
+		return param, false
+	}
+
+	if pattern.Type() == "rest_pattern" {
+		param.Variadic = true
+		if pattern.NamedChildCount() > 0 {
+			pattern = pattern.NamedChild(0)
+		}
+	}
+	param.Name = nodeText(pattern, content)
+	return param, true
+}
+
+// typeAnnotation 返回类型注解去掉前导冒号后的类型文本
+func (j *JSExtractor) typeAnnotation(node *sitter.Node, content []byte) string {
+	return strings.TrimSpace(strings.TrimPrefix(nodeText(node, content), ":"))
+}
+
+// extractModifiers 提取声明上的 async、static、abstract、readonly、override 等关键字，
+// outer 为 declare 语句时记录 declare
+func (j *JSExtractor) extractModifiers(node, outer *sitter.Node, content []byte) []string {
+	var modifiers []string
+	if outer.Type() == "ambient_declaration" {
+		modifiers = append(modifiers, "declare")
+	}
+	for _, modifier := range keywordModifiers(node, content, jsModifierKeywords) {
+		modifiers = appendModifier(modifiers, modifier)
+	}
+	return modifiers
+}
+
+// memberVisibility 返回类成员的可见性：TypeScript 访问修饰符或 # 私有名称，默认为 public
+func (j *JSExtractor) memberVisibility(node *sitter.Node, content []byte) string {
+	if name := node.ChildByFieldName("name"); name != nil && name.Type() == "private_property_identifier" {
+		return visibilityPrivate
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "accessibility_modifier" {
+			return child.Content(content)
+		}
 	}
+	return visibilityPublic
+}
+
+// extractDoc 提取节点上方紧邻的 JSDoc 注释（摘要和 @param/@returns），否则使用普通注释
+func (j *JSExtractor) extractDoc(node *sitter.Node, content []byte) (string, *models.DocComment) {
+	if prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "comment" &&
+		prev.EndPoint().Row+1 >= node.StartPoint().Row {
+		if text := prev.Content(content); strings.HasPrefix(text, "/**") {
+			return parseJSDoc(text)
+		}
+	}
+	return j.extractJSComments(node, content), nil
+}
+
+// parseJSDoc 解析 JSDoc 注释，返回摘要以及 @param 和 @returns 的结构化说明
+func parseJSDoc(text string) (string, *models.DocComment) {
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/**"), "*/")
+
+	// 按标签拆分，标签的说明可以跨多行
+	var summary []string
+	var tags []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		switch {
+		case strings.HasPrefix(line, "@"):
+			tags = append(tags, line)
+		case line == "":
+		case len(tags) > 0:
+			tags[len(tags)-1] += " " + line
+		default:
+			summary = append(summary, line)
+		}
+	}
+
+	doc := &models.DocComment{}
+	for _, tag := range tags {
+		name, rest, _ := strings.Cut(tag, " ")
+		switch name {
+		case "@param", "@arg", "@argument":
+			param := parseJSDocParam(rest, true)
+			if param.Name != "" {
+				doc.Params = append(doc.Params, param)
+			}
+		case "@returns", "@return":
+			returns := parseJSDocParam(rest, false)
+			doc.Returns = &returns
+		}
+	}
+
+	if len(doc.Params) == 0 && doc.Returns == nil {
+		doc = nil
+	}
+	return strings.Join(summary, " "), doc
+}
+
+// parseJSDocParam 解析 {Type} name - description 形式的标签内容，withName 为 false 时没有名称
+func parseJSDocParam(text string, withName bool) models.DocParam {
+	var param models.DocParam
+	text = strings.TrimSpace(text)
+
+	if strings.HasPrefix(text, "{") {
+		if end := matchBrace(text, 0); end > 0 {
+			param.Type = strings.TrimSpace(text[1:end])
+			text = strings.TrimSpace(text[end+1:])
+		}
+	}
+
+	if withName {
+		name, rest, _ := strings.Cut(text, " ")
+		// 可选参数 [name] 和带默认值的 [name=value]
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "["), "]")
+		name, _, _ = strings.Cut(name, "=")
+		param.Name = name
+		text = strings.TrimSpace(rest)
+	}
+
+	param.Description = strings.TrimSpace(strings.TrimPrefix(text, "- "))
+	return param
 }
 
 // extractJSComments 提取JS/TS注释
@@ -0,0 +1,481 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// LuaExtractor Lua语言提取器
+type LuaExtractor struct {
+	BaseExtractor
+}
+
+// NewLuaExtractor 创建Lua语言提取器
+func NewLuaExtractor() *LuaExtractor {
+	return &LuaExtractor{}
+}
+
+// GetQueries Lua的符号由 ExtractSymbols 遍历语法树提取，不使用查询规则
+func (l *LuaExtractor) GetQueries() []string {
+	return nil
+}
+
+// ExtractPrototype 提取Lua函数/表原型
+func (l *LuaExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	switch node.Type() {
+	case "function_statement":
+		return l.extractLuaFunctionPrototype(node, node, content)
+	case "variable_declaration":
+		if value := node.ChildByFieldName("value"); value != nil {
+			switch value.Type() {
+			case "function":
+				return l.extractLuaFunctionPrototype(node, value, content)
+			case "tableconstructor":
+				prototype := string(content[l.declarationStart(node, content):value.StartByte()])
+				if value.NamedChildCount() > 0 {
+					return l.cleanText(prototype + "{...}")
+				}
+				return l.cleanText(prototype + "{}")
+			}
+		}
+	case "module_return_statement":
+		return l.extractReturnPrototype(node, content)
+	}
+
+	return l.extractFullNode(node, content)
+}
+
+// ExtractMethods 提取Lua表构造器中以函数为值的字段
+func (l *LuaExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	var methods []models.Symbol
+
+	value := classNode.ChildByFieldName("value")
+	if value == nil || value.Type() != "tableconstructor" {
+		return methods
+	}
+
+	for i := 0; i < int(value.NamedChildCount()); i++ {
+		fieldList := value.NamedChild(i)
+		if fieldList == nil || fieldList.Type() != "fieldlist" {
+			continue
+		}
+
+		for j := 0; j < int(fieldList.NamedChildCount()); j++ {
+			field := fieldList.NamedChild(j)
+			if field == nil || field.Type() != "field" {
+				continue
+			}
+
+			fieldValue := field.ChildByFieldName("value")
+			if fieldValue == nil || fieldValue.Type() != "function" {
+				continue
+			}
+
+			purpose, doc := l.extractDoc(field, content)
+			method := models.Symbol{
+				Prototype: l.extractLuaFunctionPrototype(field, fieldValue, content),
+				Purpose:   purpose,
+				Range:     []int{l.declarationStartRow(field, content) + 1, int(field.EndPoint().Row) + 1},
+				Params:    l.extractParams(fieldValue, content),
+			}
+			l.applyDoc(&method, doc)
+			methods = append(methods, method)
+		}
+	}
+
+	return methods
+}
+
+// IsClassNode 检查是否是类节点（Lua中以表作为模块/类）
+func (l *LuaExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "variable_declaration"
+}
+
+// IsFunctionBodyNode 检查是否是函数体节点
+func (l *LuaExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "function_body" || nodeType == "function_end"
+}
+
+// IsInsideClass 检查节点是否在类内部
+func (l *LuaExtractor) IsInsideClass(node *sitter.Node) bool {
+	current := node.Parent()
+	for current != nil {
+		if current.Type() == "tableconstructor" {
+			return true
+		}
+		current = current.Parent()
+	}
+	return false
+}
+
+// ExtractComments 提取Lua注释（支持 -- 注释和 --- LuaDoc/EmmyLua 注解），注解标签不计入说明
+func (l *LuaExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	purpose, _ := l.extractDoc(node, content)
+	return purpose
+}
+
+// extractDoc 提取声明上方紧邻的注释：普通注释行作为说明，---@param/---@return 注解解析为结构化的文档注释，
+// 其他注解（如 ---@class）忽略
+func (l *LuaExtractor) extractDoc(node *sitter.Node, content []byte) (string, *models.DocComment) {
+	startRow := l.declarationStartRow(node, content)
+	lines := strings.Split(string(content), "\n")
+
+	var commentLines []string
+	for i := startRow - 1; i >= 0; i-- {
+		if i >= len(lines) {
+			continue
+		}
+
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			if len(commentLines) > 0 {
+				break
+			}
+			continue
+		}
+
+		// 块注释 --[[ ]] 不作为文档注释处理
+		if !strings.HasPrefix(line, "--") || strings.HasPrefix(line, "--[[") || strings.HasPrefix(line, "--]]") {
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimLeft(line, "-"))
+		if comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+
+	var summary []string
+	doc := &models.DocComment{}
+	for _, comment := range commentLines {
+		tag, rest, _ := strings.Cut(comment, " ")
+		switch {
+		case tag == "@param":
+			name, rest, _ := strings.Cut(strings.TrimSpace(rest), " ")
+			param := parseEmmyLuaType(rest)
+			param.Name = strings.TrimSuffix(name, "?")
+			if param.Name != "" {
+				doc.Params = append(doc.Params, param)
+			}
+		case tag == "@return":
+			returns := parseEmmyLuaType(rest)
+			if doc.Returns == nil {
+				doc.Returns = &returns
+			} else {
+				// 多个 ---@return 依次对应多个返回值
+				doc.Returns.Type += ", " + returns.Type
+				doc.Returns.Description = strings.TrimSpace(doc.Returns.Description + " " + returns.Description)
+			}
+		case strings.HasPrefix(tag, "@"):
+		default:
+			summary = append(summary, comment)
+		}
+	}
+
+	if len(doc.Params) == 0 && doc.Returns == nil {
+		doc = nil
+	}
+	return strings.Join(summary, " "), doc
+}
+
+// parseEmmyLuaType 解析注解中的类型和之后的说明，类型可以包含括号内以及 ,、:、| 之后的空格
+// （如 table<string, number>、fun(a: number): string、string | nil），说明开头的 # 被去掉
+func parseEmmyLuaType(text string) models.DocParam {
+	text = strings.TrimSpace(text)
+	end, depth := len(text), 0
+	for i := 0; i < len(text) && end == len(text); i++ {
+		switch text[i] {
+		case '(', '<', '{', '[':
+			depth++
+		case ')', '>', '}', ']':
+			depth--
+		case ' ', '\t':
+			before := strings.TrimSpace(text[:i])
+			after := strings.TrimSpace(text[i:])
+			if depth == 0 && !strings.HasSuffix(before, ",") && !strings.HasSuffix(before, ":") &&
+				!strings.HasSuffix(before, "|") && !strings.HasPrefix(after, "|") {
+				end = i
+			}
+		}
+	}
+	return models.DocParam{
+		Type:        strings.TrimSpace(text[:end]),
+		Description: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text[end:]), "#")),
+	}
+}
+
+// applyDoc 把文档注释中的参数和返回值类型写入函数签名，... 对应可变参数
+func (l *LuaExtractor) applyDoc(symbol *models.Symbol, doc *models.DocComment) {
+	if doc == nil {
+		return
+	}
+	symbol.Doc = doc
+	for _, docParam := range doc.Params {
+		for i := range symbol.Params {
+			if symbol.Params[i].Name == docParam.Name || (docParam.Name == "..." && symbol.Params[i].Variadic) {
+				symbol.Params[i].Type = docParam.Type
+			}
+		}
+	}
+	if doc.Returns != nil && doc.Returns.Type != "" {
+		symbol.Returns = splitTypeList(doc.Returns.Type)
+	}
+}
+
+// ExtractSymbols 遍历顶层语句提取符号，并将 M.foo / M:bar 形式的方法归组到对应的表下
+func (l *LuaExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+	tableIndex := make(map[string]int)
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child == nil {
+			continue
+		}
+
+		switch child.Type() {
+		case "function_statement":
+			tableName, _ := l.splitLuaName(l.functionName(child, content))
+			symbol := l.createSymbol(child, content)
+			if tableName == "" {
+				symbols = append(symbols, symbol)
+				continue
+			}
+			symbols = l.appendTableMethod(symbols, tableIndex, tableName, symbol)
+
+		case "variable_declaration":
+			name := l.variableName(child, content)
+			value := child.ChildByFieldName("value")
+			if name == "" || value == nil {
+				continue
+			}
+
+			switch value.Type() {
+			case "function":
+				tableName, _ := l.splitLuaName(name)
+				symbol := l.createSymbol(child, content)
+				if tableName == "" {
+					symbols = append(symbols, symbol)
+					continue
+				}
+				symbols = l.appendTableMethod(symbols, tableIndex, tableName, symbol)
+
+			case "tableconstructor":
+				symbol := l.createSymbol(child, content)
+				symbol.Methods = l.ExtractMethods(child, content)
+				if idx, exists := tableIndex[name]; exists {
+					// 方法先于表声明出现时，合并到已有的表符号中
+					symbol.Methods = append(symbol.Methods, symbols[idx].Methods...)
+					symbol.Range = mergeRange(symbol.Range, symbols[idx].Range)
+					symbols[idx] = symbol
+					continue
+				}
+				tableIndex[name] = len(symbols)
+				symbols = append(symbols, symbol)
+			}
+
+		case "module_return_statement":
+			// return M 导出模块表
+			if child.NamedChildCount() == 1 && child.NamedChild(0).Type() == "identifier" {
+				if idx, exists := tableIndex[child.NamedChild(0).Content(content)]; exists {
+					symbols[idx].Visibility = visibilityExported
+				}
+			}
+			symbols = append(symbols, l.createSymbol(child, content))
+		}
+	}
+
+	return symbols
+}
+
+// createSymbol 创建符号，范围从文档注释之后的声明开始，函数的参数和返回值类型取自 EmmyLua 注解
+func (l *LuaExtractor) createSymbol(node *sitter.Node, content []byte) models.Symbol {
+	purpose, doc := l.extractDoc(node, content)
+	symbol := models.Symbol{
+		Prototype:  l.ExtractPrototype(node, content),
+		Purpose:    purpose,
+		Range:      []int{l.declarationStartRow(node, content) + 1, int(node.EndPoint().Row) + 1},
+		Visibility: l.visibility(node),
+	}
+	switch node.Type() {
+	case "function_statement":
+		symbol.Params = l.extractParams(node, content)
+		l.applyDoc(&symbol, doc)
+	case "variable_declaration":
+		if value := node.ChildByFieldName("value"); value != nil && value.Type() == "function" {
+			symbol.Params = l.extractParams(value, content)
+			l.applyDoc(&symbol, doc)
+		}
+	}
+	return symbol
+}
+
+// extractParams 解析函数的参数名，... 记为可变参数
+func (l *LuaExtractor) extractParams(functionNode *sitter.Node, content []byte) []models.Param {
+	var params []models.Param
+	for i := 0; i < int(functionNode.NamedChildCount()); i++ {
+		list := functionNode.NamedChild(i)
+		if list.Type() != "parameter_list" {
+			continue
+		}
+		for k := 0; k < int(list.NamedChildCount()); k++ {
+			switch param := list.NamedChild(k); param.Type() {
+			case "identifier":
+				params = append(params, models.Param{Name: param.Content(content)})
+			case "ellipsis":
+				params = append(params, models.Param{Variadic: true})
+			}
+		}
+	}
+	return params
+}
+
+// visibility 以 local 声明的函数和变量只在文件内可见，全局声明和表的成员函数（M.foo、M:bar）为 public，
+// 模块返回语句没有可见性
+func (l *LuaExtractor) visibility(node *sitter.Node) string {
+	if node.Type() == "module_return_statement" {
+		return ""
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if node.NamedChild(i).Type() == "local" {
+			return visibilityPrivate
+		}
+	}
+	return visibilityPublic
+}
+
+// appendTableMethod 将方法添加到对应的表符号下，表未声明时创建一个以表名为原型的符号
+func (l *LuaExtractor) appendTableMethod(symbols []models.Symbol, tableIndex map[string]int, tableName string, method models.Symbol) []models.Symbol {
+	idx, exists := tableIndex[tableName]
+	if !exists {
+		tableIndex[tableName] = len(symbols)
+		return append(symbols, models.Symbol{
+			Prototype: tableName,
+			Range:     []int{method.Range[0], method.Range[1]},
+			Methods:   []models.Symbol{method},
+		})
+	}
+
+	symbols[idx].Methods = append(symbols[idx].Methods, method)
+	symbols[idx].Range = mergeRange(symbols[idx].Range, method.Range)
+	return symbols
+}
+
+// extractLuaFunctionPrototype 提取从声明开始到参数列表结束的函数签名
+func (l *LuaExtractor) extractLuaFunctionPrototype(declNode, functionNode *sitter.Node, content []byte) string {
+	var end uint32
+	for i := 0; i < int(functionNode.ChildCount()); i++ {
+		child := functionNode.Child(i)
+		if child != nil && child.Type() == "function_body_paren" {
+			end = child.EndByte()
+		}
+	}
+
+	start := l.declarationStart(declNode, content)
+	if end <= start {
+		return l.extractFullNode(declNode, content)
+	}
+	return l.cleanText(string(content[start:end]))
+}
+
+// extractReturnPrototype 提取模块返回语句的原型，表构造器只保留字段名
+func (l *LuaExtractor) extractReturnPrototype(node *sitter.Node, content []byte) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child == nil || child.Type() != "tableconstructor" {
+			continue
+		}
+
+		var fieldNames []string
+		for j := 0; j < int(child.NamedChildCount()); j++ {
+			fieldList := child.NamedChild(j)
+			if fieldList == nil || fieldList.Type() != "fieldlist" {
+				continue
+			}
+			for k := 0; k < int(fieldList.NamedChildCount()); k++ {
+				field := fieldList.NamedChild(k)
+				if field == nil {
+					continue
+				}
+				if name := field.ChildByFieldName("name"); name != nil {
+					fieldNames = append(fieldNames, name.Content(content))
+				} else {
+					fieldNames = append(fieldNames, l.cleanText(field.Content(content)))
+				}
+			}
+		}
+
+		if len(fieldNames) == 0 {
+			return "return {}"
+		}
+		return "return { " + strings.Join(fieldNames, ", ") + " }"
+	}
+
+	return l.extractFullNode(node, content)
+}
+
+// functionName 获取函数语句的名称（如 helper、M.add、M:bar）
+func (l *LuaExtractor) functionName(node *sitter.Node, content []byte) string {
+	if name := node.ChildByFieldName("name"); name != nil {
+		return name.Content(content)
+	}
+	return ""
+}
+
+// variableName 获取变量声明的名称（如 M、M.handler）
+func (l *LuaExtractor) variableName(node *sitter.Node, content []byte) string {
+	if name := node.ChildByFieldName("name"); name != nil {
+		return strings.TrimSpace(name.Content(content))
+	}
+	return ""
+}
+
+// splitLuaName 将 M.sub.foo / M:bar 拆分为表名和成员名
+func (l *LuaExtractor) splitLuaName(name string) (tableName, memberName string) {
+	idx := strings.LastIndexAny(name, ".:")
+	if idx < 0 {
+		return "", name
+	}
+	return name[:idx], name[idx+1:]
+}
+
+// declarationStart 获取声明的起始字节，跳过文档注释子节点以及
+// Lua 语法中被计入节点的前导空白
+func (l *LuaExtractor) declarationStart(node *sitter.Node, content []byte) uint32 {
+	start := node.StartByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.FieldNameForChild(i) == "documentation" {
+			continue
+		}
+		if child := node.Child(i); child != nil {
+			start = child.StartByte()
+		}
+		break
+	}
+
+	for start < node.EndByte() && strings.ContainsRune(" \t\r\n", rune(content[start])) {
+		start++
+	}
+	return start
+}
+
+// declarationStartRow 获取声明（跳过文档注释子节点）的起始行
+func (l *LuaExtractor) declarationStartRow(node *sitter.Node, content []byte) int {
+	start := l.declarationStart(node, content)
+	return strings.Count(string(content[:start]), "\n")
+}
+
+// mergeRange 合并两个行号范围
+func mergeRange(a, b []int) []int {
+	return []int{minInt(a[0], b[0]), maxInt(a[1], b[1])}
+}
+
+// maxInt 返回两个整数中的较大者
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,185 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// makeVariableRegex 匹配变量赋值，如 GO ?= go、export VERSION := 1.0
+	makeVariableRegex = regexp.MustCompile(`^(?:(?:export|override)\s+)*([^\s:#=]+)\s*(::=|:=|\?=|\+=|!=|=)\s*(.*)$`)
+
+	// makeDefineRegex 匹配多行变量定义 define NAME
+	makeDefineRegex = regexp.MustCompile(`^(?:(?:export|override)\s+)*define\s+(\S+)`)
+
+	// makeDirectiveRegex 匹配条件判断、include 等不输出的指令
+	makeDirectiveRegex = regexp.MustCompile(`^(ifeq|ifneq|ifdef|ifndef|else|endif|-?include|sinclude|vpath|export|unexport|undefine)\b`)
+
+	// makeSpecialTargetRegex 匹配 .PHONY、.SUFFIXES 等特殊目标
+	makeSpecialTargetRegex = regexp.MustCompile(`^\.[A-Z_]+$`)
+)
+
+// MakeExtractor Makefile 提取器（基于文本，没有可用的 Tree-sitter 语法）
+type MakeExtractor struct {
+	BaseExtractor
+}
+
+// NewMakeExtractor 创建Makefile提取器
+func NewMakeExtractor() *MakeExtractor {
+	return &MakeExtractor{}
+}
+
+// ExtractSymbols 提取目标（依赖作为原型的一部分，.PHONY 目标标记为 "kind": "phony"）和变量，
+// 目标的说明来自行尾的 ## 注释或上方的 # 注释
+func (m *MakeExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	lines := strings.Split(string(content), "\n")
+
+	var symbols []models.Symbol
+	var targetNames [][]string // 每个符号对应的目标名称，变量为空
+	phony := make(map[string]bool)
+	current := -1 // 当前正在收集命令的目标
+
+	for i := 0; i < len(lines); i++ {
+		startLine := i + 1
+		line := lines[i]
+		// 合并续行
+		for strings.HasSuffix(line, "\\") && i+1 < len(lines) {
+			i++
+			line = strings.TrimSuffix(line, "\\") + " " + strings.TrimSpace(lines[i])
+		}
+
+		// 以制表符开头的是目标的命令
+		if strings.HasPrefix(line, "\t") {
+			if current >= 0 {
+				symbols[current].Range[1] = i + 1
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		current = -1
+
+		if match := makeDefineRegex.FindStringSubmatch(trimmed); match != nil {
+			end := i
+			for end+1 < len(lines) && strings.TrimSpace(lines[end]) != "endef" {
+				end++
+			}
+			symbols = append(symbols, models.Symbol{
+				Prototype: "define " + match[1],
+				Purpose:   m.commentAbove(lines, startLine),
+				Range:     []int{startLine, end + 1},
+			})
+			targetNames = append(targetNames, nil)
+			i = end
+			continue
+		}
+
+		if match := makeVariableRegex.FindStringSubmatch(trimmed); match != nil {
+			value, _, _ := strings.Cut(match[3], " #")
+			symbols = append(symbols, models.Symbol{
+				Prototype: strings.TrimSpace(match[1] + " " + match[2] + " " + truncateConfigValue(strings.TrimSpace(value))),
+				Purpose:   m.commentAbove(lines, startLine),
+				Range:     []int{startLine, i + 1},
+			})
+			targetNames = append(targetNames, nil)
+			continue
+		}
+
+		if makeDirectiveRegex.MatchString(trimmed) {
+			continue
+		}
+
+		names, rule, purpose, ok := m.parseRule(trimmed)
+		if !ok {
+			continue
+		}
+		if len(names) == 1 && makeSpecialTargetRegex.MatchString(names[0]) {
+			if names[0] == ".PHONY" {
+				for _, name := range strings.Fields(rule) {
+					phony[name] = true
+				}
+			}
+			continue
+		}
+
+		if purpose == "" {
+			purpose = m.commentAbove(lines, startLine)
+		}
+		prototype := strings.Join(names, " ") + ":"
+		if rule != "" {
+			prototype += " " + rule
+		}
+		current = len(symbols)
+		symbols = append(symbols, models.Symbol{
+			Prototype: m.cleanText(prototype),
+			Purpose:   purpose,
+			Range:     []int{startLine, i + 1},
+		})
+		targetNames = append(targetNames, names)
+	}
+
+	for i, names := range targetNames {
+		for _, name := range names {
+			if phony[name] {
+				symbols[i].Kind = "phony"
+			}
+		}
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的 # 注释块作为文件用途
+func (m *MakeExtractor) ExtractFilePurpose(content []byte) string {
+	return leadingHashComment(strings.Split(string(content), "\n"))
+}
+
+// parseRule 解析规则行 targets: prerequisites，返回目标名称、依赖部分和行尾 ## 注释
+func (m *MakeExtractor) parseRule(line string) (names []string, rule, purpose string, ok bool) {
+	// 第一个不属于 := 的冒号（跳过变量引用中的内容）
+	colon := -1
+	depth := 0
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '(', '{':
+			depth++
+		case ')', '}':
+			depth--
+		case ':':
+			if depth == 0 && (i+1 >= len(line) || line[i+1] != '=') {
+				colon = i
+			}
+		}
+	}
+	if colon <= 0 {
+		return nil, "", "", false
+	}
+
+	names = strings.Fields(line[:colon])
+	rule = strings.TrimLeft(line[colon+1:], ":")
+	if comment := strings.Index(rule, "#"); comment >= 0 {
+		if strings.HasPrefix(rule[comment:], "##") {
+			purpose = strings.TrimSpace(strings.TrimLeft(rule[comment:], "#"))
+		}
+		rule = rule[:comment]
+	}
+	// 同一行中 ; 之后的是命令
+	rule, _, _ = strings.Cut(rule, ";")
+	return names, strings.TrimSpace(rule), purpose, len(names) > 0
+}
+
+// commentAbove 提取上方紧邻的 ## 或 # 注释，跳过目标上方的 .PHONY 声明
+func (m *MakeExtractor) commentAbove(lines []string, line int) string {
+	for line >= 2 && strings.HasPrefix(strings.TrimSpace(lines[line-2]), ".PHONY") {
+		line--
+	}
+	if comment := docCommentAbove(lines, line, "##"); comment != "" {
+		return comment
+	}
+	return docCommentAbove(lines, line, "#")
+}
@@ -0,0 +1,362 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var (
+	// markdownImageRegex 匹配图片（含徽章）
+	markdownImageRegex = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+
+	// markdownLinkRegex 匹配链接，保留链接文字
+	markdownLinkRegex = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+
+	// markdownFrontMatterRegex 匹配 front matter 中的 description 或 title
+	markdownFrontMatterRegex = regexp.MustCompile(`(?m)^(description|title)\s*:\s*["']?(.*?)["']?\s*$`)
+)
+
+// markdownEntry 文档中按顺序出现的标题、段落和代码块
+type markdownEntry struct {
+	kind      string // heading / paragraph / code
+	level     int    // 标题级别
+	text      string // 标题文字、段落文字或代码块语言
+	detail    string // 代码块的第一行代码
+	startLine int
+	endLine   int
+}
+
+// markdownSection 构建标题层级时使用的章节
+type markdownSection struct {
+	symbol   models.Symbol
+	level    int
+	children []*markdownSection
+}
+
+// MarkdownExtractor Markdown 文档提取器
+type MarkdownExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewMarkdownExtractor 创建Markdown文档提取器
+func NewMarkdownExtractor() *MarkdownExtractor {
+	return &MarkdownExtractor{
+		queries: []string{
+			"(atx_heading) @symbol",
+			"(setext_heading) @symbol",
+			"(fenced_code_block) @symbol",
+		},
+	}
+}
+
+// GetQueries 获取Markdown的Tree-sitter查询规则
+func (m *MarkdownExtractor) GetQueries() []string {
+	return m.queries
+}
+
+// ExtractPrototype 提取标题（统一为 # 形式）或代码块的开始标记
+func (m *MarkdownExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	entry, ok := m.nodeToEntry(node, content)
+	if !ok {
+		return m.extractFullNode(node, content)
+	}
+	return m.entryPrototype(entry)
+}
+
+// ExtractMethods Markdown没有方法，返回空
+func (m *MarkdownExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	return []models.Symbol{}
+}
+
+// IsClassNode 检查是否是章节节点
+func (m *MarkdownExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "section"
+}
+
+// IsFunctionBodyNode Markdown没有函数体
+func (m *MarkdownExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return false
+}
+
+// IsInsideClass 检查节点是否在章节内部
+func (m *MarkdownExtractor) IsInsideClass(node *sitter.Node) bool {
+	return node.Parent() != nil && node.Parent().Type() == "section"
+}
+
+// ExtractComments 提取标题之后第一个段落的第一句话
+func (m *MarkdownExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	for sibling := node.NextNamedSibling(); sibling != nil; sibling = sibling.NextNamedSibling() {
+		switch sibling.Type() {
+		case "atx_heading", "setext_heading", "section":
+			return ""
+		case "paragraph":
+			if text := m.cleanInline(sibling.Content(content)); text != "" {
+				return m.firstSentence(text)
+			}
+		}
+	}
+	return ""
+}
+
+// ExtractSymbols 按标题层级输出章节，代码块作为所在章节的子符号
+func (m *MarkdownExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	entries := m.collectEntries(root, content, nil)
+	lastLine := strings.Count(strings.TrimRight(string(content), "\n"), "\n") + 1
+
+	document := &markdownSection{}
+	stack := []*markdownSection{document}
+	closeSection := func(section *markdownSection, nextLine int) {
+		section.symbol.Range[1] = maxInt(section.symbol.Range[0], m.lastContentLine(content, nextLine-1))
+	}
+
+	for i, entry := range entries {
+		switch entry.kind {
+		case "heading":
+			// 关闭同级及更低级别的章节
+			for len(stack) > 1 && stack[len(stack)-1].level >= entry.level {
+				closeSection(stack[len(stack)-1], entry.startLine)
+				stack = stack[:len(stack)-1]
+			}
+
+			section := &markdownSection{
+				symbol: models.Symbol{
+					Prototype: m.entryPrototype(entry),
+					Purpose:   m.sectionPurpose(entries[i+1:]),
+					Range:     []int{entry.startLine, lastLine},
+				},
+				level: entry.level,
+			}
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, section)
+			stack = append(stack, section)
+
+		case "code":
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, &markdownSection{
+				symbol: models.Symbol{
+					Prototype: m.entryPrototype(entry),
+					Purpose:   entry.detail,
+					Range:     []int{entry.startLine, entry.endLine},
+				},
+			})
+		}
+	}
+
+	for len(stack) > 1 {
+		closeSection(stack[len(stack)-1], lastLine+1)
+		stack = stack[:len(stack)-1]
+	}
+
+	return m.sectionSymbols(document.children)
+}
+
+// ExtractFilePurpose 提取 front matter 的 description/title，否则使用第一个段落的第一句话，没有段落时使用一级标题
+func (m *MarkdownExtractor) ExtractFilePurpose(content []byte) string {
+	text := string(content)
+
+	// front matter
+	if strings.HasPrefix(text, "---\n") {
+		if end := strings.Index(text[4:], "\n---"); end >= 0 {
+			frontMatter := text[4 : 4+end]
+			var title string
+			for _, match := range markdownFrontMatterRegex.FindAllStringSubmatch(frontMatter, -1) {
+				if match[1] == "description" && match[2] != "" {
+					return match[2]
+				}
+				title = match[2]
+			}
+			if title != "" {
+				return title
+			}
+			text = text[4+end+4:]
+		}
+	}
+
+	var title string
+	for _, block := range strings.Split(text, "\n\n") {
+		block = strings.TrimSpace(block)
+		switch {
+		case block == "", strings.HasPrefix(block, "<"), strings.HasPrefix(block, "```"),
+			strings.HasPrefix(block, "- "), strings.HasPrefix(block, "* "), strings.HasPrefix(block, ">"):
+			continue
+		case strings.HasPrefix(block, "#"):
+			if title == "" {
+				firstLine, _, _ := strings.Cut(block, "\n")
+				title = m.cleanInline(strings.TrimLeft(firstLine, "# "))
+			}
+			// 标题下紧跟的段落
+			if _, rest, found := strings.Cut(block, "\n"); found && !strings.HasPrefix(strings.TrimSpace(rest), "#") {
+				if sentence := m.firstSentence(m.cleanInline(rest)); sentence != "" {
+					return sentence
+				}
+			}
+		default:
+			if sentence := m.firstSentence(m.cleanInline(block)); sentence != "" {
+				return sentence
+			}
+		}
+	}
+
+	return title
+}
+
+// collectEntries 按文档顺序收集标题、段落和代码块
+func (m *MarkdownExtractor) collectEntries(node *sitter.Node, content []byte, entries []markdownEntry) []markdownEntry {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child == nil {
+			continue
+		}
+
+		switch child.Type() {
+		case "section":
+			entries = m.collectEntries(child, content, entries)
+		default:
+			if entry, ok := m.nodeToEntry(child, content); ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// nodeToEntry 将标题、段落或代码块节点转换为文档条目
+func (m *MarkdownExtractor) nodeToEntry(node *sitter.Node, content []byte) (markdownEntry, bool) {
+	entry := markdownEntry{
+		startLine: int(node.StartPoint().Row) + 1,
+		endLine:   m.endLine(node),
+	}
+
+	switch node.Type() {
+	case "atx_heading":
+		entry.kind = "heading"
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			marker := node.NamedChild(i).Type()
+			if strings.HasPrefix(marker, "atx_h") && strings.HasSuffix(marker, "_marker") {
+				entry.level = int(marker[len("atx_h")] - '0')
+			}
+		}
+		if heading := node.ChildByFieldName("heading_content"); heading != nil {
+			entry.text = m.cleanInline(heading.Content(content))
+		}
+
+	case "setext_heading":
+		entry.kind = "heading"
+		entry.level = 2
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if node.NamedChild(i).Type() == "setext_h1_underline" {
+				entry.level = 1
+			}
+		}
+		if heading := node.ChildByFieldName("heading_content"); heading != nil {
+			entry.text = m.cleanInline(heading.Content(content))
+		}
+
+	case "paragraph":
+		entry.kind = "paragraph"
+		entry.text = m.cleanInline(node.Content(content))
+
+	case "fenced_code_block":
+		entry.kind = "code"
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			switch child.Type() {
+			case "info_string":
+				if language := child.NamedChild(0); language != nil && language.Type() == "language" {
+					entry.text = language.Content(content)
+				}
+			case "code_fence_content":
+				for _, line := range strings.Split(child.Content(content), "\n") {
+					if line = strings.TrimSpace(line); line != "" {
+						entry.detail = truncateConfigValue(line)
+						break
+					}
+				}
+			}
+		}
+
+	default:
+		return entry, false
+	}
+
+	return entry, true
+}
+
+// entryPrototype 生成标题（# 形式）或代码块（```语言）的原型
+func (m *MarkdownExtractor) entryPrototype(entry markdownEntry) string {
+	if entry.kind == "code" {
+		return "```" + entry.text
+	}
+	return strings.Repeat("#", entry.level) + " " + entry.text
+}
+
+// sectionPurpose 取标题之后、下一个标题之前第一个段落的第一句话
+func (m *MarkdownExtractor) sectionPurpose(entries []markdownEntry) string {
+	for _, entry := range entries {
+		switch entry.kind {
+		case "heading":
+			return ""
+		case "paragraph":
+			if entry.text != "" {
+				return m.firstSentence(entry.text)
+			}
+		}
+	}
+	return ""
+}
+
+// sectionSymbols 将章节树转换为符号，子章节和代码块作为子符号
+func (m *MarkdownExtractor) sectionSymbols(sections []*markdownSection) []models.Symbol {
+	var symbols []models.Symbol
+	for _, section := range sections {
+		symbol := section.symbol
+		symbol.Children = m.sectionSymbols(section.children)
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// endLine 返回节点结束行，不计入节点末尾包含的换行
+func (m *MarkdownExtractor) endLine(node *sitter.Node) int {
+	end := node.EndPoint()
+	if end.Column == 0 && end.Row > node.StartPoint().Row {
+		return int(end.Row)
+	}
+	return int(end.Row) + 1
+}
+
+// lastContentLine 从指定行向上查找最后一个非空行
+func (m *MarkdownExtractor) lastContentLine(content []byte, line int) int {
+	lines := strings.Split(string(content), "\n")
+	for line > 0 && line <= len(lines) && strings.TrimSpace(lines[line-1]) == "" {
+		line--
+	}
+	return line
+}
+
+// cleanInline 去除图片、链接地址和强调标记，合并空白
+func (m *MarkdownExtractor) cleanInline(text string) string {
+	text = markdownImageRegex.ReplaceAllString(text, "")
+	text = markdownLinkRegex.ReplaceAllString(text, "$1")
+	text = strings.NewReplacer("**", "", "__", "", "`", "").Replace(text)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// firstSentence 截取文本的第一句话
+func (m *MarkdownExtractor) firstSentence(text string) string {
+	for i, r := range text {
+		switch r {
+		case '。', '！', '？':
+			return text[:i+len(string(r))]
+		case '.', '!', '?':
+			if i+1 == len(text) || text[i+1] == ' ' {
+				return text[:i+1]
+			}
+		}
+	}
+	return text
+}
@@ -0,0 +1,458 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// objcSyntax Objective-C 的注释和字符串语法
+	objcSyntax = textSyntax{
+		lineComments:  []string{"//"},
+		blockComments: [][2]string{{"/*", "*/"}},
+		quotes:        `"'`,
+	}
+
+	// objcContainerRegex 匹配 @interface/@implementation/@protocol 头部（包括分类、父类和协议列表）
+	objcContainerRegex = regexp.MustCompile(`^@(interface|implementation|protocol)\s+\w+(\s*\(\s*\w*\s*\))?(\s*:\s*\w+)?(\s*<[^>]*>)?`)
+
+	// objcForwardRegex 匹配前向声明，如 @class Foo; 或 @protocol Bar;
+	objcForwardRegex = regexp.MustCompile(`^@(class|protocol)\s+[\w\s,]+;`)
+
+	// objcMethodRegex 匹配实例方法或类方法
+	objcMethodRegex = regexp.MustCompile(`^[-+]\s*\(`)
+
+	// objcDirectiveRegex 匹配容器内无需输出的指令
+	objcDirectiveRegex = regexp.MustCompile(`^@(optional|required|private|public|protected|package)\b`)
+
+	// objcTypeRegex 匹配类型声明（typedef、struct、enum、NS_ENUM、NS_OPTIONS）
+	objcTypeRegex = regexp.MustCompile(`^(typedef\s+)?(struct|enum|union|NS_ENUM|NS_OPTIONS|NS_CLOSED_ENUM|NS_ERROR_ENUM)\b`)
+
+	// objcPropertyAttributesRegex 匹配属性声明的特性列表，如 @property (nonatomic, readonly)
+	objcPropertyAttributesRegex = regexp.MustCompile(`^@property\s*\(([^)]*)\)`)
+
+	// objcFileHeaderRegex 匹配 Xcode 文件模板中的文件名、创建者和版权行
+	objcFileHeaderRegex = regexp.MustCompile(`^(\S+\.(h|m|mm)|Created by .*|Copyright .*|.*All rights reserved\.?)$`)
+)
+
+// ObjCExtractor Objective-C/Objective-C++ 提取器（基于文本，没有可用的 Tree-sitter 语法）
+type ObjCExtractor struct {
+	BaseExtractor
+}
+
+// NewObjCExtractor 创建Objective-C提取器
+func NewObjCExtractor() *ObjCExtractor {
+	return &ObjCExtractor{}
+}
+
+// objcStatement 拆分出的一条语句或声明
+type objcStatement struct {
+	start     int    // 开始位置
+	end       int    // 结束位置（不含）
+	bodyStart int    // 函数体、方法体或类型体 { 的位置，没有时为 -1
+	head      string // 屏蔽后的头部文本（不含函数体）
+}
+
+// ExtractSymbols 提取 @interface、@implementation、@protocol（包括分类），
+// 方法作为方法，属性和实例变量作为子符号；同时提取顶层 C 函数和类型声明
+func (o *ObjCExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	text := string(content)
+	masked := maskSource(text, objcSyntax)
+	lines := strings.Split(text, "\n")
+
+	var symbols []models.Symbol
+	for i := skipSpace(masked, 0); i < len(masked); i = skipSpace(masked, i) {
+		rest := masked[i:]
+		switch {
+		case rest[0] == '#':
+			// 预处理指令
+			i = o.lineEnd(masked, i)
+
+		case objcForwardRegex.MatchString(rest):
+			i += objcForwardRegex.FindStringIndex(rest)[1]
+
+		case objcContainerRegex.MatchString(rest):
+			var symbol models.Symbol
+			symbol, i = o.containerSymbol(text, masked, lines, i)
+			symbols = append(symbols, symbol)
+
+		case rest[0] == '@':
+			// @import、@end 等其他指令
+			i = o.lineEnd(masked, i)
+
+		default:
+			statement := o.nextStatement(masked, i, len(masked))
+			i = statement.end
+			if symbol, ok := o.topLevelSymbol(text, masked, lines, statement); ok {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的注释作为文件用途，跳过 Xcode 模板中的文件名、项目名、创建者和版权信息
+func (o *ObjCExtractor) ExtractFilePurpose(content []byte) string {
+	var commentLines []string
+	templateHeader := false
+	for index, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "//") {
+			if trimmed == "" && len(commentLines) == 0 && !templateHeader {
+				continue
+			}
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimLeft(trimmed, "/"))
+		switch {
+		case comment == "":
+			continue
+		case objcFileHeaderRegex.MatchString(comment):
+			// 模板第二行是文件名，第三行是项目名
+			if index <= 1 && strings.Contains(comment, ".") {
+				templateHeader = true
+			}
+			continue
+		case templateHeader && index == 2:
+			continue
+		}
+		commentLines = append(commentLines, comment)
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// containerSymbol 创建 @interface/@implementation/@protocol 符号，返回 @end 之后的位置
+func (o *ObjCExtractor) containerSymbol(text, masked string, lines []string, start int) (models.Symbol, int) {
+	headerEnd := start + objcContainerRegex.FindStringIndex(masked[start:])[1]
+	end := len(masked)
+	if idx := strings.Index(masked[headerEnd:], "@end"); idx >= 0 {
+		end = headerEnd + idx
+	}
+
+	startLine := lineOfOffset(masked, start)
+	symbol := models.Symbol{
+		Prototype: o.cleanText(text[start:headerEnd]),
+		Purpose:   o.docComment(lines, startLine),
+		Range:     []int{startLine, lineOfOffset(masked, minInt(end+len("@end"), len(masked))-1)},
+	}
+	match := objcContainerRegex.FindStringSubmatch(masked[start:])
+	if match[2] != "" {
+		symbol.Kind = "category"
+	}
+
+	// @interface 和 @protocol 中声明的方法和属性是公开的，类扩展 @interface Foo () 中的是私有的；
+	// @implementation 中的方法是否公开取决于头文件中的声明，不记录可见性
+	memberVisibility := ""
+	ivarVisibility := visibilityPrivate
+	if match[1] != "implementation" {
+		memberVisibility = visibilityPublic
+		ivarVisibility = visibilityProtected
+		if strings.Join(strings.Fields(match[2]), "") == "()" {
+			memberVisibility = visibilityPrivate
+		}
+	}
+
+	// 紧跟在头部之后的实例变量块
+	memberStart := skipSpace(masked, headerEnd)
+	if memberStart < end && masked[memberStart] == '{' {
+		if closeIdx := matchBrace(masked, memberStart); closeIdx > 0 && closeIdx < end {
+			symbol.Children = append(symbol.Children, o.instanceVariables(text, masked, lines, memberStart+1, closeIdx, ivarVisibility)...)
+			memberStart = closeIdx + 1
+		}
+	}
+
+	optional := false // 协议中 @optional 之后的方法
+	for i := skipSpace(masked, memberStart); i < end; i = skipSpace(masked, i) {
+		rest := masked[i:end]
+		switch {
+		case rest[0] == '#':
+			i = o.lineEnd(masked, i)
+
+		case objcDirectiveRegex.MatchString(rest):
+			loc := objcDirectiveRegex.FindStringIndex(rest)
+			switch rest[:loc[1]] {
+			case "@optional":
+				optional = true
+			case "@required":
+				optional = false
+			}
+			i += loc[1]
+
+		default:
+			statement := o.nextStatement(masked, i, end)
+			i = statement.end
+			switch {
+			case objcMethodRegex.MatchString(statement.head):
+				method := o.createSymbol(text, masked, lines, statement)
+				method.Visibility = memberVisibility
+				method.Params, method.Returns = o.methodSignature(text, masked, statement)
+				if statement.head[0] == '+' {
+					method.Modifiers = append(method.Modifiers, "class")
+				}
+				if optional {
+					method.Modifiers = append(method.Modifiers, "optional")
+				}
+				symbol.Methods = append(symbol.Methods, method)
+			case strings.HasPrefix(statement.head, "@property"):
+				property := o.createSymbol(text, masked, lines, statement)
+				property.Visibility = memberVisibility
+				property.Modifiers = o.propertyAttributes(statement.head)
+				symbol.Children = append(symbol.Children, property)
+			}
+		}
+	}
+
+	return symbol, minInt(end+len("@end"), len(masked))
+}
+
+// instanceVariables 提取实例变量块中的变量声明，可见性取自前面最近的 @private 等标记，
+// 没有标记时使用 visibility（@interface 中为 protected，@implementation 中为 private）
+func (o *ObjCExtractor) instanceVariables(text, masked string, lines []string, start, end int, visibility string) []models.Symbol {
+	var variables []models.Symbol
+	for i := skipSpace(masked, start); i < end; i = skipSpace(masked, i) {
+		if loc := objcDirectiveRegex.FindStringIndex(masked[i:end]); loc != nil {
+			visibility = strings.TrimPrefix(masked[i:i+loc[1]], "@")
+			i += loc[1]
+			continue
+		}
+		statement := o.nextStatement(masked, i, end)
+		i = statement.end
+		if statement.head != "" {
+			variable := o.createSymbol(text, masked, lines, statement)
+			variable.Visibility = visibility
+			variables = append(variables, variable)
+		}
+	}
+	return variables
+}
+
+// propertyAttributes 提取属性声明的特性（如 nonatomic、readonly、copy），跳过 getter=/setter=
+func (o *ObjCExtractor) propertyAttributes(head string) []string {
+	match := objcPropertyAttributesRegex.FindStringSubmatch(head)
+	if match == nil {
+		return nil
+	}
+	var attributes []string
+	for _, attribute := range strings.Split(match[1], ",") {
+		if attribute = strings.TrimSpace(attribute); attribute != "" && !strings.Contains(attribute, "=") {
+			attributes = appendModifier(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// topLevelSymbol 将顶层语句转换为 C 函数或类型声明符号，跳过变量和宏调用
+func (o *ObjCExtractor) topLevelSymbol(text, masked string, lines []string, statement objcStatement) (models.Symbol, bool) {
+	if match := objcTypeRegex.FindStringSubmatch(statement.head); match != nil {
+		symbol := o.createSymbol(text, masked, lines, statement)
+		if match[2] != "struct" && match[2] != "union" {
+			if statement.bodyStart >= 0 {
+				if closeIdx := matchBrace(masked, statement.bodyStart); closeIdx > 0 {
+					symbol.Children = o.enumConstants(text, masked, lines, statement.bodyStart+1, closeIdx)
+				}
+			}
+		}
+		return symbol, true
+	}
+
+	// 函数定义或声明：参数列表之前没有赋值
+	paren := strings.IndexByte(statement.head, '(')
+	if paren <= 0 || strings.Contains(statement.head[:paren], "=") || strings.HasPrefix(statement.head, "typedef") {
+		return models.Symbol{}, false
+	}
+	if statement.bodyStart < 0 && !strings.HasSuffix(statement.head, ")") {
+		return models.Symbol{}, false
+	}
+	// static 函数只在本文件内可见
+	symbol := o.createSymbol(text, masked, lines, statement)
+	symbol.Visibility = visibilityPublic
+	if strings.HasPrefix(statement.head, "static ") {
+		symbol.Visibility = visibilityPrivate
+	}
+	symbol.Params, symbol.Returns = o.functionSignature(text, masked, statement.start, statement.start+paren)
+	return symbol, true
+}
+
+// methodSignature 解析方法声明：开头括号中为返回类型，每个 label:(Type)name 片段为一个参数，
+// 结尾的 , ... 为可变参数；void 视为没有返回值
+func (o *ObjCExtractor) methodSignature(text, masked string, statement objcStatement) ([]models.Param, []string) {
+	end := statement.end
+	if statement.bodyStart >= 0 {
+		end = statement.bodyStart
+	}
+
+	var returns []string
+	i := skipSpace(masked, statement.start+1)
+	if i < end && masked[i] == '(' {
+		closeIdx := matchBrace(masked, i)
+		if closeIdx < 0 || closeIdx >= end {
+			return nil, nil
+		}
+		if returnType := compactText(text[i+1 : closeIdx]); returnType != "void" && returnType != "" {
+			returns = []string{returnType}
+		}
+		i = closeIdx + 1
+	}
+
+	var params []models.Param
+	for {
+		colon := strings.IndexByte(masked[i:end], ':')
+		if colon < 0 {
+			break
+		}
+		i = skipSpace(masked, i+colon+1)
+		var param models.Param
+		if i < end && masked[i] == '(' {
+			closeIdx := matchBrace(masked, i)
+			if closeIdx < 0 || closeIdx >= end {
+				break
+			}
+			param.Type = compactText(text[i+1 : closeIdx])
+			i = skipSpace(masked, closeIdx+1)
+		}
+		nameEnd := i
+		for nameEnd < end && isWordChar(masked[nameEnd]) {
+			nameEnd++
+		}
+		param.Name = text[i:nameEnd]
+		params = append(params, param)
+		i = nameEnd
+	}
+	if strings.Contains(masked[i:end], "...") {
+		params = append(params, models.Param{Variadic: true})
+	}
+	return params, returns
+}
+
+// functionSignature 解析从 start 开始的 C 函数声明，paren 为参数列表的 ( 位置：名称之前去掉存储类说明符的部分为返回类型，
+// 每个参数的最后一个标识符为参数名；单独的 void 表示没有参数
+func (o *ObjCExtractor) functionSignature(text, masked string, start, paren int) ([]models.Param, []string) {
+	closeIdx := matchBrace(masked, paren)
+	if closeIdx < 0 {
+		return nil, nil
+	}
+
+	var params []models.Param
+	for _, segment := range splitGenericParamList(text, masked, paren+1, closeIdx) {
+		switch segment {
+		case "void":
+		case "...":
+			params = append(params, models.Param{Variadic: true})
+		default:
+			name, paramType := splitTypedName(segment)
+			params = append(params, models.Param{Name: name, Type: compactText(paramType)})
+		}
+	}
+
+	_, returnType := splitTypedName(text[start:paren])
+	words := strings.Fields(returnType)
+	for len(words) > 0 && (words[0] == "static" || words[0] == "extern" || words[0] == "inline") {
+		words = words[1:]
+	}
+	if returnType = strings.Join(words, " "); returnType == "" || returnType == "void" {
+		return params, nil
+	}
+	return params, []string{returnType}
+}
+
+// enumConstants 提取枚举常量
+func (o *ObjCExtractor) enumConstants(text, masked string, lines []string, start, end int) []models.Symbol {
+	var constants []models.Symbol
+	for _, segment := range splitTopLevelCommas(masked, start, end) {
+		first := skipSpace(masked, segment[0])
+		if first >= segment[1] {
+			continue
+		}
+		constants = append(constants, o.createSymbol(text, masked, lines, objcStatement{
+			start:     first,
+			end:       segment[1],
+			bodyStart: -1,
+			head:      strings.TrimSpace(masked[first:segment[1]]),
+		}))
+	}
+	return constants
+}
+
+// nextStatement 从 start 开始读取一条语句：以顶层 ; 结束，或以函数体、方法体、类型体的 } 结束；
+// 遇到行首的预处理指令、@ 指令或方法声明时提前结束
+func (o *ObjCExtractor) nextStatement(masked string, start, end int) objcStatement {
+	statement := objcStatement{start: start, end: end, bodyStart: -1}
+	for i := start; i < end; i++ {
+		if i > start && o.atLineStart(masked, i) && strings.IndexByte("#@-+", masked[i]) >= 0 {
+			statement.end = i
+			break
+		}
+
+		switch masked[i] {
+		case '(', '[':
+			if closeIdx := matchBrace(masked, i); closeIdx > i && closeIdx < end {
+				i = closeIdx
+			}
+		case '{':
+			statement.bodyStart = i
+			closeIdx := matchBrace(masked, i)
+			if closeIdx < 0 || closeIdx >= end {
+				closeIdx = end - 1
+			}
+			statement.end = closeIdx + 1
+			// 类型声明以 }; 或 } Name; 结束
+			if objcTypeRegex.MatchString(strings.TrimSpace(masked[start:i])) {
+				if semicolon := strings.IndexByte(masked[statement.end:end], ';'); semicolon >= 0 {
+					statement.end += semicolon + 1
+				}
+			}
+			statement.head = strings.TrimSpace(masked[start:i])
+			return statement
+		case ';':
+			statement.end = i + 1
+			statement.head = strings.TrimSpace(masked[start:i])
+			return statement
+		}
+	}
+
+	statement.head = strings.TrimSpace(masked[start:statement.end])
+	return statement
+}
+
+// createSymbol 创建符号：原型不含函数体和结尾分号，类型声明保留 } 之后的类型名
+func (o *ObjCExtractor) createSymbol(text, masked string, lines []string, statement objcStatement) models.Symbol {
+	headEnd := statement.end
+	if statement.bodyStart >= 0 {
+		headEnd = statement.bodyStart
+	}
+	prototype := strings.TrimSuffix(o.cleanText(text[statement.start:headEnd]), ";")
+
+	startLine := lineOfOffset(masked, statement.start)
+	return models.Symbol{
+		Prototype: strings.TrimSpace(prototype),
+		Purpose:   o.docComment(lines, startLine),
+		Range:     []int{startLine, lineOfOffset(masked, statement.end-1)},
+	}
+}
+
+// docComment 提取上方的 ///、/** */ 或 // 注释
+func (o *ObjCExtractor) docComment(lines []string, line int) string {
+	if comment := docCommentAbove(lines, line, "///"); comment != "" {
+		return comment
+	}
+	return docCommentAbove(lines, line, "//")
+}
+
+// atLineStart 检查 i 之前到行首是否只有空白
+func (o *ObjCExtractor) atLineStart(masked string, i int) bool {
+	lineStart := strings.LastIndexByte(masked[:i], '\n') + 1
+	return strings.TrimSpace(masked[lineStart:i]) == ""
+}
+
+// lineEnd 返回 i 所在行的行尾位置
+func (o *ObjCExtractor) lineEnd(masked string, i int) int {
+	if idx := strings.IndexByte(masked[i:], '\n'); idx >= 0 {
+		return i + idx
+	}
+	return len(masked)
+}
@@ -0,0 +1,485 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+const (
+	// ocamlInterfaceName 包装接口文件时使用的模块类型名称
+	ocamlInterfaceName = "OutlineInterface__"
+
+	// ocamlInterfacePrefix 接口文件的包装前缀，与第一行位于同一行以保持行号不变
+	ocamlInterfacePrefix = "module type " + ocamlInterfaceName + " = sig "
+)
+
+// ocamlNameRegex 从原型中提取声明类别和名称，用于匹配接口与实现
+var ocamlNameRegex = regexp.MustCompile(`^(let|and|val|external|type|module type|module|exception)\s+(?:rec\s+|nonrec\s+)?(?:'\w+\s+|\([^)]*\)\s+)?(\(.*?\)|[\w']+)`)
+
+// ocamlNameKinds 原型关键字对应的名称空间
+var ocamlNameKinds = map[string]string{
+	"let":         "value",
+	"and":         "value",
+	"val":         "value",
+	"external":    "value",
+	"type":        "type",
+	"module":      "module",
+	"module type": "module type",
+	"exception":   "exception",
+}
+
+// OCamlExtractor OCaml语言提取器（.ml 实现文件和 .mli 接口文件）
+type OCamlExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewOCamlExtractor 创建OCaml语言提取器
+func NewOCamlExtractor() *OCamlExtractor {
+	return &OCamlExtractor{
+		queries: []string{
+			"(compilation_unit (value_definition) @symbol)",
+			"(compilation_unit (type_definition) @symbol)",
+			"(compilation_unit (module_definition) @symbol)",
+			"(compilation_unit (module_type_definition) @symbol)",
+		},
+	}
+}
+
+// GetQueries 获取OCaml语言的Tree-sitter查询规则
+func (o *OCamlExtractor) GetQueries() []string {
+	return o.queries
+}
+
+// ExtractPrototype 提取声明原型：let 绑定不含函数体，模块和类型不含结构体、签名和构造器列表
+func (o *OCamlExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	switch node.Type() {
+	case "module_definition", "module_type_definition":
+		if body := o.moduleBody(node); body != nil {
+			return o.prototypeBefore(node, body, content)
+		}
+	case "value_definition":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if binding := node.NamedChild(i); binding.Type() == "let_binding" {
+				return o.bindingPrototype(node, binding, content)
+			}
+		}
+	case "type_definition":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if binding := node.NamedChild(i); binding.Type() == "type_binding" {
+				return o.typePrototype(node, binding, content)
+			}
+		}
+	}
+	return o.extractFullNode(node, content)
+}
+
+// ExtractMethods 提取模块中的 let 绑定、val 和 external 声明
+func (o *OCamlExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	if body := o.moduleBody(classNode); body != nil {
+		_, values := o.extractItems(body, content)
+		return values
+	}
+	return []models.Symbol{}
+}
+
+// IsClassNode 检查是否是模块或模块类型定义节点
+func (o *OCamlExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "module_definition" || nodeType == "module_type_definition"
+}
+
+// IsFunctionBodyNode 检查是否是模块结构体或签名
+func (o *OCamlExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "structure" || nodeType == "signature"
+}
+
+// IsInsideClass 检查节点是否在模块内部
+func (o *OCamlExtractor) IsInsideClass(node *sitter.Node) bool {
+	for current := node.Parent(); current != nil; current = current.Parent() {
+		if current.Type() == "structure" || current.Type() == "signature" {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractComments 提取声明的文档注释：优先使用紧邻上方的注释，否则使用紧随其后的 (** *) 注释
+func (o *OCamlExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	startRow := node.StartPoint().Row
+	if prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "comment" && prev.EndPoint().Row+1 == startRow {
+		// 紧跟在上一个声明之后的注释属于上一个声明
+		owner := prev.PrevNamedSibling()
+		if owner == nil || owner.Type() == "comment" || owner.EndPoint().Row+1 < prev.StartPoint().Row {
+			return o.commentText(prev.Content(content))
+		}
+	}
+
+	endRow := node.EndPoint().Row
+	if next := node.NextNamedSibling(); next != nil && next.Type() == "comment" && next.StartPoint().Row <= endRow+1 {
+		if text := next.Content(content); strings.HasPrefix(text, "(**") {
+			return o.commentText(text)
+		}
+	}
+	return ""
+}
+
+// ExtractSymbols 提取顶层声明；模块中的 let/val 作为方法，嵌套模块、类型和异常作为子符号
+func (o *OCamlExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	// 包装后的接口文件：取出包装模块类型中的签名项
+	if first := root.NamedChild(0); first != nil && first.Type() == "module_type_definition" {
+		if name := first.ChildByFieldName("name"); name != nil && name.Content(content) == ocamlInterfaceName {
+			if body := o.moduleBody(first); body != nil {
+				root = body
+			}
+		}
+	}
+
+	declarations, values := o.extractItems(root, content)
+	// 顶层声明按源码顺序输出
+	symbols := append(declarations, values...)
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return symbols[i].Range[0] < symbols[j].Range[0]
+	})
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的注释作为文件用途
+func (o *OCamlExtractor) ExtractFilePurpose(content []byte) string {
+	text := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(text, "(*") {
+		return ""
+	}
+	end := strings.Index(text, "*)")
+	if end < 0 {
+		return ""
+	}
+	return o.commentText(text[:end+2])
+}
+
+// extractItems 提取结构体或签名中的声明，返回类型/模块/异常声明和值声明
+func (o *OCamlExtractor) extractItems(block *sitter.Node, content []byte) (declarations []models.Symbol, values []models.Symbol) {
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		item := block.NamedChild(i)
+		switch item.Type() {
+		case "value_definition":
+			values = append(values, o.valueSymbols(item, content)...)
+
+		case "value_specification", "external":
+			symbol := o.createSymbol(item, o.extractFullNode(item, content), content)
+			symbol.Params, symbol.Returns = o.functionTypeSignature(item, content)
+			values = append(values, symbol)
+
+		case "type_definition":
+			declarations = append(declarations, o.typeSymbols(item, content)...)
+
+		case "exception_definition":
+			declarations = append(declarations, o.createSymbol(item, o.extractFullNode(item, content), content))
+
+		case "module_definition", "module_type_definition":
+			symbol := o.createSymbol(item, o.ExtractPrototype(item, content), content)
+			if body := o.moduleBody(item); body != nil {
+				symbol.Children, symbol.Methods = o.extractItems(body, content)
+			}
+			declarations = append(declarations, symbol)
+		}
+	}
+	return declarations, values
+}
+
+// valueSymbols 为 let 定义中的每个命名绑定（包括 and 连接的绑定）创建符号，跳过 let () = ... 等模式绑定
+func (o *OCamlExtractor) valueSymbols(node *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		binding := node.NamedChild(i)
+		if binding.Type() != "let_binding" {
+			continue
+		}
+		pattern := binding.ChildByFieldName("pattern")
+		if pattern == nil || (pattern.Type() != "value_name" && pattern.Type() != "parenthesized_operator") {
+			continue
+		}
+
+		symbol := o.createSymbol(binding, o.bindingPrototype(node, binding, content), content)
+		symbol.Params, symbol.Returns = o.bindingSignature(binding, content)
+		if len(symbols) == 0 {
+			symbol.Range[0] = int(node.StartPoint().Row) + 1
+			symbol.Purpose = o.ExtractComments(node, content)
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// typeSymbols 为类型定义中的每个类型创建符号，变体构造器和记录字段作为子符号
+func (o *OCamlExtractor) typeSymbols(node *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		binding := node.NamedChild(i)
+		if binding.Type() != "type_binding" {
+			continue
+		}
+
+		symbol := o.createSymbol(binding, o.typePrototype(node, binding, content), content)
+		for j := 0; j < int(binding.NamedChildCount()); j++ {
+			// 类型名之前的 'a、('k, 'v) 为类型参数
+			if variable := binding.NamedChild(j); variable.Type() == "type_variable" {
+				symbol.TypeParams = append(symbol.TypeParams, models.TypeParam{Name: variable.Content(content)})
+			}
+		}
+		if len(symbols) == 0 {
+			symbol.Range[0] = int(node.StartPoint().Row) + 1
+			symbol.Purpose = o.ExtractComments(node, content)
+		}
+		if body := binding.ChildByFieldName("body"); body != nil {
+			for j := 0; j < int(body.NamedChildCount()); j++ {
+				member := body.NamedChild(j)
+				if member.Type() == "constructor_declaration" || member.Type() == "field_declaration" {
+					symbol.Children = append(symbol.Children, o.createSymbol(member, o.extractFullNode(member, content), content))
+				}
+			}
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// bindingSignature 解析 let 绑定的参数和返回类型标注：~label 和 ?label 保留标签前缀，
+// ?(x = 1) 的默认值和 (x : int) 的类型单独记录；没有参数的绑定不是函数，不记录返回类型
+func (o *OCamlExtractor) bindingSignature(binding *sitter.Node, content []byte) ([]models.Param, []string) {
+	var params []models.Param
+	var returns []string
+	for i := 0; i < int(binding.ChildCount()); i++ {
+		child := binding.Child(i)
+		switch {
+		case child.Type() == "parameter":
+			params = append(params, o.parameter(child, content))
+		case child.Type() == ":" && len(params) > 0 && i+1 < int(binding.ChildCount()):
+			returns = []string{nodeText(binding.Child(i+1), content)}
+		}
+	}
+	return params, returns
+}
+
+// parameter 解析 let 绑定的单个参数
+func (o *OCamlExtractor) parameter(node *sitter.Node, content []byte) models.Param {
+	var param models.Param
+	prefix := ""
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch {
+		case child.Type() == "~" || child.Type() == "?":
+			prefix = child.Type()
+		case node.FieldNameForChild(i) == "pattern":
+			param.Name = nodeText(child, content)
+			if child.Type() == "typed_pattern" && child.NamedChildCount() == 2 {
+				param.Name = nodeText(child.NamedChild(0), content)
+				param.Type = nodeText(child.NamedChild(1), content)
+			}
+		case child.Type() == ":" && i+1 < int(node.ChildCount()):
+			param.Type = nodeText(node.Child(i+1), content)
+		case child.Type() == "=" && i+1 < int(node.ChildCount()):
+			param.Default = nodeText(node.Child(i+1), content)
+		}
+	}
+	if param.Name == "" {
+		// 没有 pattern 字段的参数（如 ~label 简写）
+		param.Name = strings.TrimLeft(nodeText(node, content), "~?")
+	}
+	param.Name = prefix + param.Name
+	return param
+}
+
+// functionTypeSignature 按 -> 拆分 val 和 external 声明的函数类型：除最后一项外都是参数类型（带标签的参数记录标签名），
+// 最后一项为返回类型；不是函数类型时不记录
+func (o *OCamlExtractor) functionTypeSignature(node *sitter.Node, content []byte) ([]models.Param, []string) {
+	var current *sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "function_type" {
+			current = child
+			break
+		}
+	}
+	if current == nil {
+		return nil, nil
+	}
+
+	var params []models.Param
+	for current.Type() == "function_type" && current.NamedChildCount() == 2 {
+		domain := current.NamedChild(0)
+		param := models.Param{Type: nodeText(domain, content)}
+		if domain.Type() == "typed_label" && domain.NamedChildCount() == 2 {
+			param.Name = strings.TrimSuffix(nodeText(domain.NamedChild(0), content), ":")
+			if strings.HasPrefix(domain.Content(content), "?") {
+				param.Name = "?" + param.Name
+			} else {
+				param.Name = "~" + param.Name
+			}
+			param.Type = nodeText(domain.NamedChild(1), content)
+		}
+		params = append(params, param)
+		current = current.NamedChild(1)
+	}
+	return params, []string{nodeText(current, content)}
+}
+
+// createSymbol 创建符号
+func (o *OCamlExtractor) createSymbol(node *sitter.Node, prototype string, content []byte) models.Symbol {
+	return models.Symbol{
+		Prototype: prototype,
+		Purpose:   o.ExtractComments(node, content),
+		Range:     []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+	}
+}
+
+// bindingPrototype 提取 let 绑定的原型（名称、参数和类型标注），第一个绑定包含 let/let rec 关键字
+func (o *OCamlExtractor) bindingPrototype(definition, binding *sitter.Node, content []byte) string {
+	start := binding.StartByte()
+	prefix := "and "
+	if definition.NamedChild(0) != nil && definition.NamedChild(0).Equal(binding) {
+		start = definition.StartByte()
+		prefix = ""
+	}
+
+	end := binding.EndByte()
+	if body := binding.ChildByFieldName("body"); body != nil {
+		end = body.StartByte()
+	}
+	prototype := o.cleanText(string(content[start:end]))
+	return prefix + strings.TrimSpace(strings.TrimSuffix(prototype, "="))
+}
+
+// typePrototype 提取类型原型，变体和记录类型不包含构造器和字段
+func (o *OCamlExtractor) typePrototype(definition, binding *sitter.Node, content []byte) string {
+	start := binding.StartByte()
+	prefix := "and "
+	if definition.NamedChild(0) != nil && definition.NamedChild(0).Equal(binding) {
+		start = definition.StartByte()
+		prefix = ""
+	}
+
+	end := binding.EndByte()
+	if body := binding.ChildByFieldName("body"); body != nil &&
+		(body.Type() == "variant_declaration" || body.Type() == "record_declaration") {
+		end = body.StartByte()
+	}
+	prototype := o.cleanText(string(content[start:end]))
+	return prefix + strings.TrimSpace(strings.TrimSuffix(prototype, "="))
+}
+
+// prototypeBefore 提取从节点开始到 body 之前的文本，去除结尾的 = 或 :
+func (o *OCamlExtractor) prototypeBefore(node, body *sitter.Node, content []byte) string {
+	prototype := o.cleanText(string(content[node.StartByte():body.StartByte()]))
+	return strings.TrimSpace(strings.TrimRight(prototype, "=: "))
+}
+
+// moduleBody 获取模块定义的结构体（struct ... end）或签名（sig ... end）
+func (o *OCamlExtractor) moduleBody(node *sitter.Node) *sitter.Node {
+	if node.Type() == "module_type_definition" {
+		if body := node.ChildByFieldName("body"); body != nil && body.Type() == "signature" {
+			return body
+		}
+		return nil
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		binding := node.NamedChild(i)
+		if binding.Type() != "module_binding" {
+			continue
+		}
+		for j := 0; j < int(binding.NamedChildCount()); j++ {
+			if child := binding.NamedChild(j); child.Type() == "structure" || child.Type() == "signature" {
+				return child
+			}
+		}
+	}
+	return nil
+}
+
+// commentText 去除 (* *) 注释标记，返回第一段文字
+func (o *OCamlExtractor) commentText(comment string) string {
+	comment = strings.TrimPrefix(comment, "(*")
+	comment = strings.TrimPrefix(comment, "*")
+	comment = strings.TrimSuffix(comment, "*)")
+	paragraph, _, _ := strings.Cut(strings.TrimSpace(comment), "\n\n")
+	return o.cleanText(paragraph)
+}
+
+// parseOCaml 解析 OCaml 实现文件或接口文件；实现文件存在同名 .mli 接口时，
+// 符号缺少说明的部分和文件用途优先使用接口中的文档注释
+func (p *TreeSitterParser) parseOCaml(filePath string, content []byte) ([]models.Symbol, string, error) {
+	extractor := NewOCamlExtractor()
+	purpose := extractor.ExtractFilePurpose(content)
+
+	if strings.EqualFold(filepath.Ext(filePath), ".mli") {
+		// 接口文件由签名项组成，包装为模块类型后按实现文件的语法解析
+		wrapped := append([]byte(ocamlInterfacePrefix), content...)
+		wrapped = append(wrapped, "\nend\n"...)
+		symbols, err := p.parseSymbols(filePath, wrapped, langOCaml)
+		return symbols, purpose, err
+	}
+
+	symbols, err := p.parseSymbols(filePath, content, langOCaml)
+	if err != nil {
+		return nil, "", err
+	}
+
+	interfacePath := strings.TrimSuffix(filePath, filepath.Ext(filePath)) + ".mli"
+	interfaceContent, err := os.ReadFile(interfacePath)
+	if err != nil {
+		return symbols, purpose, nil
+	}
+	if interfaceSymbols, _, err := p.parseOCaml(interfacePath, interfaceContent); err == nil {
+		docs := make(map[string]string)
+		collectOCamlDocs(interfaceSymbols, "", docs)
+		applyOCamlDocs(symbols, "", docs)
+	}
+	if purpose == "" {
+		purpose = extractor.ExtractFilePurpose(interfaceContent)
+	}
+	return symbols, purpose, nil
+}
+
+// ocamlSymbolKey 生成符号在所属模块路径下的标识，如 "value Stack.push"
+func ocamlSymbolKey(symbol models.Symbol, path string) (key, name string) {
+	match := ocamlNameRegex.FindStringSubmatch(symbol.Prototype)
+	if match == nil {
+		return "", ""
+	}
+	return ocamlNameKinds[match[1]] + " " + path + match[2], match[2]
+}
+
+// collectOCamlDocs 收集接口符号的说明
+func collectOCamlDocs(symbols []models.Symbol, path string, docs map[string]string) {
+	for _, symbol := range symbols {
+		key, name := ocamlSymbolKey(symbol, path)
+		if key == "" {
+			continue
+		}
+		if symbol.Purpose != "" {
+			docs[key] = symbol.Purpose
+		}
+		collectOCamlDocs(symbol.Methods, path+name+".", docs)
+		collectOCamlDocs(symbol.Children, path+name+".", docs)
+	}
+}
+
+// applyOCamlDocs 为缺少说明的实现符号填充接口中的说明
+func applyOCamlDocs(symbols []models.Symbol, path string, docs map[string]string) {
+	for i := range symbols {
+		key, name := ocamlSymbolKey(symbols[i], path)
+		if key == "" {
+			continue
+		}
+		if symbols[i].Purpose == "" {
+			symbols[i].Purpose = docs[key]
+		}
+		applyOCamlDocs(symbols[i].Methods, path+name+".", docs)
+		applyOCamlDocs(symbols[i].Children, path+name+".", docs)
+	}
+}
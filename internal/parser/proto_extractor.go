@@ -0,0 +1,186 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// ProtoExtractor Protocol Buffers 提取器
+type ProtoExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewProtoExtractor 创建Protocol Buffers提取器
+func NewProtoExtractor() *ProtoExtractor {
+	return &ProtoExtractor{
+		queries: []string{
+			"(package) @symbol",
+			"(message) @symbol",
+			"(enum) @symbol",
+			"(service) @symbol",
+		},
+	}
+}
+
+// GetQueries 获取Protocol Buffers的Tree-sitter查询规则
+func (p *ProtoExtractor) GetQueries() []string {
+	return p.queries
+}
+
+// ExtractPrototype 提取消息/枚举/服务的声明部分，字段和 rpc 返回完整声明
+func (p *ProtoExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	text := node.Content(content)
+
+	// 只保留定义体之前的部分
+	if idx := strings.IndexAny(text, "{;"); idx >= 0 {
+		text = text[:idx]
+	}
+
+	return p.cleanText(text)
+}
+
+// ExtractMethods 提取服务中的 rpc 方法
+func (p *ProtoExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	var methods []models.Symbol
+	if classNode.Type() != "service" {
+		return methods
+	}
+
+	for i := 0; i < int(classNode.NamedChildCount()); i++ {
+		child := classNode.NamedChild(i)
+		if child != nil && child.Type() == "rpc" {
+			methods = append(methods, p.createSymbol(child, content))
+		}
+	}
+
+	return methods
+}
+
+// IsClassNode 检查是否是消息/枚举/服务节点
+func (p *ProtoExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "message" || nodeType == "enum" || nodeType == "service"
+}
+
+// IsFunctionBodyNode 检查是否是定义体节点
+func (p *ProtoExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "message_body" || nodeType == "enum_body"
+}
+
+// IsInsideClass 检查节点是否嵌套在消息内部
+func (p *ProtoExtractor) IsInsideClass(node *sitter.Node) bool {
+	current := node.Parent()
+	for current != nil {
+		if current.Type() == "message" {
+			return true
+		}
+		current = current.Parent()
+	}
+	return false
+}
+
+// ExtractComments 提取声明上方的注释，没有时使用行尾注释
+func (p *ProtoExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	if comment := extractMultiLineComments(node, content); comment != "" {
+		return comment
+	}
+
+	lines := strings.Split(string(content), "\n")
+	row := int(node.EndPoint().Row)
+	if row < len(lines) {
+		if idx := strings.Index(lines[row], "//"); idx >= 0 {
+			return strings.TrimSpace(lines[row][idx+2:])
+		}
+	}
+	return ""
+}
+
+// ExtractSymbols 提取顶层的包、消息、枚举和服务，字段与嵌套类型作为子符号
+func (p *ProtoExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child == nil {
+			continue
+		}
+
+		switch child.Type() {
+		case "package", "message", "enum", "service":
+			symbols = append(symbols, p.createSymbol(child, content))
+		}
+	}
+
+	return symbols
+}
+
+// createSymbol 创建符号，并递归提取消息字段、枚举值和服务方法
+func (p *ProtoExtractor) createSymbol(node *sitter.Node, content []byte) models.Symbol {
+	symbol := models.Symbol{
+		Prototype: p.ExtractPrototype(node, content),
+		Purpose:   p.ExtractComments(node, content),
+		Range:     []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+	}
+
+	switch node.Type() {
+	case "message", "enum", "oneof":
+		symbol.Children = p.extractMembers(node, content)
+	case "service":
+		symbol.Methods = p.ExtractMethods(node, content)
+	case "rpc":
+		symbol.Params, symbol.Returns = p.rpcSignature(node, content)
+	}
+
+	return symbol
+}
+
+// rpcSignature 解析 rpc 的请求和响应消息类型，流式消息的类型写作 stream Name
+func (p *ProtoExtractor) rpcSignature(node *sitter.Node, content []byte) ([]models.Param, []string) {
+	var params []models.Param
+	var returns []string
+	stream, afterReturns := false, false
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch child.Type() {
+		case "stream":
+			stream = true
+		case "returns":
+			afterReturns = true
+		case "message_or_enum_type":
+			messageType := nodeText(child, content)
+			if stream {
+				messageType = "stream " + messageType
+			}
+			if afterReturns {
+				returns = append(returns, messageType)
+			} else {
+				params = append(params, models.Param{Type: messageType})
+			}
+			stream = false
+		}
+	}
+	return params, returns
+}
+
+// extractMembers 提取消息/枚举/oneof 的成员（字段及字段编号、枚举值、嵌套类型）
+func (p *ProtoExtractor) extractMembers(node *sitter.Node, content []byte) []models.Symbol {
+	var members []models.Symbol
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child == nil {
+			continue
+		}
+
+		switch child.Type() {
+		case "message_body", "enum_body":
+			members = append(members, p.extractMembers(child, content)...)
+		case "field", "map_field", "oneof_field", "enum_field", "message", "enum", "oneof":
+			members = append(members, p.createSymbol(child, content))
+		}
+	}
+
+	return members
+}
@@ -1,12 +1,32 @@
 package parser
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/cnwinds/code-outline/internal/models"
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+var (
+	// pythonConstantRegex 匹配常量命名（全大写），如 MAX_RETRIES、_DEFAULT_TIMEOUT
+	pythonConstantRegex = regexp.MustCompile(`^_*[A-Z][A-Z0-9_]*$`)
+
+	// pythonTypeFactoryRegex 匹配创建类型的调用，如 TypeVar("T")、NewType("UserId", int)
+	pythonTypeFactoryRegex = regexp.MustCompile(`^(?:typing\.)?(TypeVar|NewType|ParamSpec|TypeVarTuple)\(`)
+
+	// pythonHeaderLineRegex 匹配文件开头的 shebang 和编码声明
+	pythonHeaderLineRegex = regexp.MustCompile(`^#!|^#.*coding[:=]`)
+)
+
+// pythonDecoratorModifiers 表示修饰符语义的装饰器
+var pythonDecoratorModifiers = map[string]string{
+	"staticmethod":   "static",
+	"classmethod":    "classmethod",
+	"property":       "property",
+	"abstractmethod": "abstract",
+}
+
 // PythonExtractor Python语言提取器
 type PythonExtractor struct {
 	BaseExtractor
@@ -28,54 +48,49 @@ func (p *PythonExtractor) GetQueries() []string {
 	return p.queries
 }
 
-// ExtractPrototype 提取Python函数/类原型
+// ExtractPrototype 提取Python函数/类原型（包含装饰器，不包含函数体/类体）
 func (p *PythonExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
-	nodeType := node.Type()
-
-	// 对于类定义，只提取类声明部分（不包含类体）
-	if nodeType == "class_definition" {
-		return p.extractClassPrototype(node, content)
+	definition := node
+	var decorators []string
+	if node.Type() == "decorated_definition" {
+		definition = node.ChildByFieldName("definition")
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if child := node.NamedChild(i); child.Type() == "decorator" {
+				decorators = append(decorators, p.cleanText(child.Content(content)))
+			}
+		}
+		if definition == nil {
+			return p.extractFullNode(node, content)
+		}
 	}
 
-	// 对于函数定义，提取函数签名
-	if nodeType == "function_definition" {
-		return p.extractFunctionPrototype(node, content, p.IsFunctionBodyNode)
+	switch definition.Type() {
+	case "function_definition", "class_definition":
+		prototype := p.cleanText(string(content[definition.StartByte():p.headerEnd(definition)]))
+		return strings.TrimSpace(strings.Join(decorators, " ") + " " + prototype)
+	default:
+		return p.extractFullNode(definition, content)
 	}
-
-	return p.extractFullNode(node, content)
 }
 
-// ExtractMethods 提取Python类内部的方法
-func (p *PythonExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
-	var methods []models.Symbol
-
-	childCount := int(classNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := classNode.Child(i)
-		if child == nil {
-			continue
+// headerEnd 返回函数/类声明头中冒号之后的位置，不包含冒号与函数体之间的注释
+func (p *PythonExtractor) headerEnd(definition *sitter.Node) uint32 {
+	end := definition.EndByte()
+	for i := 0; i < int(definition.ChildCount()); i++ {
+		child := definition.Child(i)
+		if p.IsFunctionBodyNode(child.Type()) {
+			break
 		}
-
-		childType := child.Type()
-		// 查找类体
-		if childType == "block" {
-			// 遍历类体中的方法
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
-
-				bodyChildType := bodyChild.Type()
-				if bodyChildType == "function_definition" {
-					method := p.createMethodSymbol(bodyChild, content)
-					methods = append(methods, method)
-				}
-			}
+		if child.Type() == ":" {
+			end = child.EndByte()
 		}
 	}
+	return end
+}
 
+// ExtractMethods 提取Python类内部的方法（包含带装饰器的方法）
+func (p *PythonExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	methods, _ := p.extractClassBody(classNode, content)
 	return methods
 }
 
@@ -102,114 +117,386 @@ func (p *PythonExtractor) IsInsideClass(node *sitter.Node) bool {
 	return false
 }
 
-// ExtractComments 提取Python注释
+// ExtractComments 提取Python文档：优先使用 docstring 的摘要，否则使用上方的 # 注释
 func (p *PythonExtractor) ExtractComments(node *sitter.Node, content []byte) string {
-	return p.extractPythonComments(node, content)
+	definition := node
+	if node.Type() == "decorated_definition" {
+		if inner := node.ChildByFieldName("definition"); inner != nil {
+			definition = inner
+		}
+	}
+
+	if body := definition.ChildByFieldName("body"); body != nil {
+		if docstring := p.docstring(body, content); docstring != "" {
+			return docstring
+		}
+	}
+	return docCommentAbove(strings.Split(string(content), "\n"), int(node.StartPoint().Row)+1, "#")
 }
 
-// extractClassPrototype 提取Python类原型
-func (p *PythonExtractor) extractClassPrototype(node *sitter.Node, content []byte) string {
-	fullText := string(content[node.StartByte():node.EndByte()])
-	lines := strings.Split(fullText, "\n")
+// ExtractSymbols 遍历模块顶层语句提取函数、类、常量、类型别名和 __all__，
+// 同时进入顶层的 if/try/with 语句（如 if TYPE_CHECKING:），但不进入函数体
+func (p *PythonExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		node := root.NamedChild(i)
+
+		switch node.Type() {
+		case "function_definition", "class_definition", "decorated_definition":
+			symbols = append(symbols, p.createDefinitionSymbol(node, content))
 
-	for i, line := range lines {
-		if strings.Contains(line, ":") && !strings.Contains(line, "::") {
-			idx := strings.Index(line, ":")
-			if i == 0 {
-				return strings.TrimSpace(line[:idx+1])
+		case "type_alias_statement":
+			symbol := p.createSymbol(node, p.cleanText(node.Content(content)), p.assignmentComment(node, content))
+			if left := node.ChildByFieldName("left"); left != nil {
+				symbol.Visibility = underscoreVisibility(left.Content(content))
 			}
-			result := strings.Join(lines[:i], "\n") + "\n" + line[:idx+1]
-			return strings.TrimSpace(result)
+			symbols = append(symbols, symbol)
+
+		case "expression_statement":
+			if symbol, ok := p.createModuleAssignment(node, content); ok {
+				symbols = append(symbols, symbol)
+			}
+
+		case "if_statement", "try_statement", "with_statement":
+			// 条件导入或兼容代码中的定义
+			if !p.isMainGuard(node, content) {
+				symbols = append(symbols, p.ExtractSymbols(node, content)...)
+			}
+
+		case "block", "else_clause", "elif_clause", "except_clause", "finally_clause":
+			symbols = append(symbols, p.ExtractSymbols(node, content)...)
 		}
 	}
-	return ""
+
+	return symbols
 }
 
-// createMethodSymbol 创建方法符号
-func (p *PythonExtractor) createMethodSymbol(node *sitter.Node, content []byte) models.Symbol {
-	start := node.StartPoint()
-	end := node.EndPoint()
+// ExtractFilePurpose 提取模块 docstring 的摘要，否则使用文件开头的 # 注释（跳过 shebang 和编码声明）
+func (p *PythonExtractor) ExtractFilePurpose(content []byte) string {
+	lines := strings.Split(string(content), "\n")
+	start := 0
+	for start < len(lines) && pythonHeaderLineRegex.MatchString(strings.TrimSpace(lines[start])) {
+		start++
+	}
 
-	prototype := p.extractFunctionPrototype(node, content, p.IsFunctionBodyNode)
+	for i := start; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
 
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   p.extractPythonComments(node, content),
-		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
+		quote := strings.TrimLeft(trimmed, "rRuU")
+		if !strings.HasPrefix(quote, `"""`) && !strings.HasPrefix(quote, "'''") {
+			break
+		}
+		delimiter := quote[:3]
+		text := strings.Join(lines[i:], "\n")
+		text = text[strings.Index(text, delimiter)+3:]
+		if end := strings.Index(text, delimiter); end >= 0 {
+			return pythonDocstringSummary(text[:end])
+		}
+		break
 	}
+
+	return leadingHashComment(lines[start:])
 }
 
-// extractPythonComments 提取Python注释（支持docstring）
-func (p *PythonExtractor) extractPythonComments(node *sitter.Node, content []byte) string {
-	startPoint := node.StartPoint()
-	startRow := int(startPoint.Row)
-	lines := strings.Split(string(content), "\n")
+// createDefinitionSymbol 创建函数或类符号，类的方法作为 Methods，嵌套类和字段作为 Children
+func (p *PythonExtractor) createDefinitionSymbol(node *sitter.Node, content []byte) models.Symbol {
+	symbol := p.createSymbol(node, p.ExtractPrototype(node, content), p.ExtractComments(node, content))
 
-	// 首先检查docstring
-	for i := startRow; i < len(lines) && i < startRow+5; i++ {
-		line := strings.TrimSpace(lines[i])
-		if strings.HasPrefix(line, "\"\"\"") || strings.HasPrefix(line, "'''") {
-			// 找到docstring开始
-			quote := "\"\"\""
-			if strings.HasPrefix(line, "'''") {
-				quote = "'''"
-			}
+	definition := node
+	if node.Type() == "decorated_definition" {
+		definition = node.ChildByFieldName("definition")
+	}
+	if definition == nil {
+		return symbol
+	}
+	if name := definition.ChildByFieldName("name"); name != nil {
+		symbol.Visibility = underscoreVisibility(name.Content(content))
+	}
+	symbol.Modifiers = p.extractModifiers(node, definition, content)
+	symbol.TypeParams = p.extractTypeParams(definition.ChildByFieldName("type_parameters"), content)
+	if definition.Type() == "function_definition" {
+		symbol.Params = p.extractParams(definition.ChildByFieldName("parameters"), content)
+		if returnType := definition.ChildByFieldName("return_type"); returnType != nil {
+			symbol.Returns = []string{nodeText(returnType, content)}
+		}
+	}
+	if definition.Type() == "class_definition" {
+		symbol.Methods, symbol.Children = p.extractClassBody(definition, content)
+	}
+	return symbol
+}
 
-			// 检查是否是单行docstring
-			if strings.HasSuffix(line, quote) && len(line) > 6 {
-				docstring := strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(line, quote), quote))
-				if docstring != "" {
-					return docstring
-				}
-			}
+// extractModifiers 提取函数的 async 关键字，以及 @staticmethod、@classmethod、@property、@abstractmethod 装饰器对应的修饰符
+func (p *PythonExtractor) extractModifiers(node, definition *sitter.Node, content []byte) []string {
+	modifiers := keywordModifiers(definition, content, map[string]bool{"async": true})
+	if node == definition {
+		return modifiers
+	}
 
-			// 多行docstring
-			var docLines []string
-			firstLine := strings.TrimSpace(strings.TrimPrefix(line, quote))
-			if firstLine != "" {
-				docLines = append(docLines, firstLine)
-			}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		decorator := node.NamedChild(i)
+		if decorator.Type() != "decorator" {
+			continue
+		}
+		name := strings.TrimSpace(strings.TrimPrefix(decorator.Content(content), "@"))
+		if paren := strings.Index(name, "("); paren >= 0 {
+			name = name[:paren]
+		}
+		name = name[strings.LastIndex(name, ".")+1:]
+		if modifier, ok := pythonDecoratorModifiers[name]; ok {
+			modifiers = appendModifier(modifiers, modifier)
+		}
+	}
+	return modifiers
+}
 
-			for j := i + 1; j < len(lines) && j < i+10; j++ {
-				docLine := strings.TrimSpace(lines[j])
-				if strings.HasSuffix(docLine, quote) {
-					lastLine := strings.TrimSpace(strings.TrimSuffix(docLine, quote))
-					if lastLine != "" {
-						docLines = append(docLines, lastLine)
-					}
-					break
-				}
-				if docLine != "" {
-					docLines = append(docLines, docLine)
-				}
-			}
+// extractParams 解析参数列表：*args 和 **kwargs 标记为可变参数，/ 和 * 分隔符不作为参数
+func (p *PythonExtractor) extractParams(list *sitter.Node, content []byte) []models.Param {
+	if list == nil {
+		return nil
+	}
 
-			if len(docLines) > 0 {
-				return strings.Join(docLines, " ")
-			}
+	var params []models.Param
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		node := list.NamedChild(i)
+		param := models.Param{
+			Type:    fieldText(node, "type", content),
+			Default: fieldText(node, "value", content),
+		}
+
+		name := node.ChildByFieldName("name")
+		if name == nil && node.NamedChildCount() > 0 {
+			// identifier 和 typed_parameter 的名称没有字段名，是第一个子节点
+			name = node.NamedChild(0)
+		}
+		switch node.Type() {
+		case "identifier":
+			name = node
+		case "list_splat_pattern", "dictionary_splat_pattern":
+			param.Variadic = true
+		case "positional_separator", "keyword_separator":
+			continue
+		}
+		if name != nil && (name.Type() == "list_splat_pattern" || name.Type() == "dictionary_splat_pattern") {
+			// 带类型注解的 *args: int
+			param.Variadic = true
+			name = name.NamedChild(0)
 		}
+		param.Name = nodeText(name, content)
+		params = append(params, param)
+	}
+	return params
+}
+
+// extractTypeParams 解析 PEP 695 类型参数列表（def f[T: int, *Ts]），T: int 的冒号后为约束
+func (p *PythonExtractor) extractTypeParams(list *sitter.Node, content []byte) []models.TypeParam {
+	if list == nil {
+		return nil
 	}
 
-	// 检查普通注释
-	for i := startRow - 1; i >= 0; i-- {
-		line := strings.TrimSpace(lines[i])
-		if line == "" {
+	var typeParams []models.TypeParam
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		typeParam := models.TypeParam{Name: nodeText(list.NamedChild(i), content)}
+		if constrained := list.NamedChild(i).NamedChild(0); constrained != nil && constrained.Type() == "constrained_type" && constrained.NamedChildCount() == 2 {
+			typeParam.Name = nodeText(constrained.NamedChild(0), content)
+			typeParam.Constraint = nodeText(constrained.NamedChild(1), content)
+		}
+		typeParams = append(typeParams, typeParam)
+	}
+	return typeParams
+}
+
+// extractClassBody 提取类体中的方法，以及嵌套类和带类型注解的字段（dataclass、pydantic 等）
+func (p *PythonExtractor) extractClassBody(classNode *sitter.Node, content []byte) (methods, children []models.Symbol) {
+	body := classNode.ChildByFieldName("body")
+	if body == nil {
+		return nil, nil
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		node := body.NamedChild(i)
+
+		definition := node
+		if node.Type() == "decorated_definition" {
+			definition = node.ChildByFieldName("definition")
+		}
+		if definition == nil {
 			continue
 		}
 
-		// 检查单行注释
-		if strings.HasPrefix(line, "#") {
-			comment := strings.TrimSpace(strings.TrimPrefix(line, "#"))
-			if comment != "" {
-				return comment
+		switch definition.Type() {
+		case "function_definition":
+			methods = append(methods, p.createDefinitionSymbol(node, content))
+		case "class_definition":
+			children = append(children, p.createDefinitionSymbol(node, content))
+		case "expression_statement":
+			assignment := p.assignment(node)
+			if assignment == nil || assignment.ChildByFieldName("type") == nil {
+				continue
 			}
+			child := p.createSymbol(node, p.assignmentPrototype(assignment, content), p.assignmentComment(node, content))
+			if left := assignment.ChildByFieldName("left"); left != nil {
+				child.Visibility = underscoreVisibility(left.Content(content))
+			}
+			children = append(children, child)
 		}
+	}
 
-		// 如果遇到非注释行，停止查找
-		if !strings.HasPrefix(line, "#") && line != "" {
-			break
+	return methods, children
+}
+
+// createModuleAssignment 创建模块级赋值符号：__all__、常量和类型别名
+func (p *PythonExtractor) createModuleAssignment(node *sitter.Node, content []byte) (models.Symbol, bool) {
+	assignment := p.assignment(node)
+	if assignment == nil {
+		return models.Symbol{}, false
+	}
+	left := assignment.ChildByFieldName("left")
+	if left == nil || left.Type() != "identifier" {
+		return models.Symbol{}, false
+	}
+
+	name := left.Content(content)
+	prototype := p.assignmentPrototype(assignment, content)
+	switch {
+	case name == "__all__":
+		// 导出列表不截断
+		prototype = p.cleanText(assignment.Content(content))
+	case pythonConstantRegex.MatchString(name), p.isTypeAlias(assignment, content):
+	default:
+		return models.Symbol{}, false
+	}
+
+	symbol := p.createSymbol(node, prototype, p.assignmentComment(node, content))
+	symbol.Visibility = underscoreVisibility(name)
+	return symbol, true
+}
+
+// createSymbol 创建以节点范围为行号范围的符号
+func (p *PythonExtractor) createSymbol(node *sitter.Node, prototype, purpose string) models.Symbol {
+	return models.Symbol{
+		Prototype: prototype,
+		Purpose:   purpose,
+		Range:     []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+	}
+}
+
+// assignment 返回表达式语句中的赋值节点，不是赋值时返回 nil
+func (p *PythonExtractor) assignment(node *sitter.Node) *sitter.Node {
+	if node.Type() != "expression_statement" || node.NamedChildCount() != 1 {
+		return nil
+	}
+	if child := node.NamedChild(0); child.Type() == "assignment" {
+		return child
+	}
+	return nil
+}
+
+// assignmentPrototype 提取赋值原型，过长的值会被截断
+func (p *PythonExtractor) assignmentPrototype(assignment *sitter.Node, content []byte) string {
+	left := assignment.ChildByFieldName("left")
+	if left == nil {
+		return p.cleanText(assignment.Content(content))
+	}
+
+	prototype := left.Content(content)
+	if typeNode := assignment.ChildByFieldName("type"); typeNode != nil {
+		prototype += ": " + p.cleanText(typeNode.Content(content))
+	}
+	if right := assignment.ChildByFieldName("right"); right != nil {
+		prototype += " = " + truncateConfigValue(p.cleanText(right.Content(content)))
+	}
+	return prototype
+}
+
+// isTypeAlias 检查赋值是否为类型别名：X: TypeAlias = ...、T = TypeVar("T") 或 JSON = dict[str, Any]
+func (p *PythonExtractor) isTypeAlias(assignment *sitter.Node, content []byte) bool {
+	if typeNode := assignment.ChildByFieldName("type"); typeNode != nil {
+		return strings.HasSuffix(typeNode.Content(content), "TypeAlias")
+	}
+
+	right := assignment.ChildByFieldName("right")
+	if right == nil {
+		return false
+	}
+	if right.Type() == "call" {
+		return pythonTypeFactoryRegex.MatchString(right.Content(content))
+	}
+	name := assignment.ChildByFieldName("left").Content(content)
+	return right.Type() == "subscript" && name[0] >= 'A' && name[0] <= 'Z'
+}
+
+// assignmentComment 提取赋值的说明：紧随其后的属性 docstring、同一行的 # 注释或上方的 # 注释
+func (p *PythonExtractor) assignmentComment(node *sitter.Node, content []byte) string {
+	if next := node.NextNamedSibling(); next != nil {
+		switch {
+		case next.Type() == "comment" && next.StartPoint().Row == node.EndPoint().Row:
+			return strings.TrimSpace(strings.TrimPrefix(next.Content(content), "#"))
+		case next.Type() == "expression_statement" && next.StartPoint().Row == node.EndPoint().Row+1:
+			if docstring := p.stringStatement(next, content); docstring != "" {
+				return docstring
+			}
 		}
 	}
+	return docCommentAbove(strings.Split(string(content), "\n"), int(node.StartPoint().Row)+1, "#")
+}
 
+// docstring 提取函数体或类体中第一条语句的 docstring 摘要
+func (p *PythonExtractor) docstring(body *sitter.Node, content []byte) string {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		if child.Type() == "comment" {
+			continue
+		}
+		return p.stringStatement(child, content)
+	}
 	return ""
 }
+
+// stringStatement 语句只包含一个字符串字面量时返回其摘要
+func (p *PythonExtractor) stringStatement(node *sitter.Node, content []byte) string {
+	if node.Type() != "expression_statement" || node.NamedChildCount() != 1 {
+		return ""
+	}
+	str := node.NamedChild(0)
+	if str.Type() != "string" {
+		return ""
+	}
+
+	var text strings.Builder
+	for i := 0; i < int(str.NamedChildCount()); i++ {
+		if part := str.NamedChild(i); part.Type() == "string_content" {
+			text.WriteString(part.Content(content))
+		}
+	}
+	return pythonDocstringSummary(text.String())
+}
+
+// isMainGuard 检查是否为 if __name__ == "__main__": 语句
+func (p *PythonExtractor) isMainGuard(node *sitter.Node, content []byte) bool {
+	if node.Type() != "if_statement" {
+		return false
+	}
+	condition := node.ChildByFieldName("condition")
+	return condition != nil && strings.Contains(condition.Content(content), "__name__")
+}
+
+// pythonDocstringSummary 返回 docstring 的摘要（第一个空行之前的段落）
+func pythonDocstringSummary(docstring string) string {
+	var summary []string
+	for _, line := range strings.Split(docstring, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if len(summary) > 0 {
+				break
+			}
+			continue
+		}
+		summary = append(summary, trimmed)
+	}
+	return strings.Join(summary, " ")
+}
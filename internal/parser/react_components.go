@@ -0,0 +1,220 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+const (
+	reactKindComponent = "component"
+	reactKindHook      = "hook"
+)
+
+var (
+	// reactHookNameRegex 匹配 hook 命名，如 useState、useUserProfile
+	reactHookNameRegex = regexp.MustCompile(`^use[A-Z0-9]`)
+
+	// reactComponentBaseRegex 匹配类组件的基类
+	reactComponentBaseRegex = regexp.MustCompile(`^(React\.)?(Pure)?Component$`)
+)
+
+// reactWrappers 包装函数组件的高阶函数
+var reactWrappers = map[string]bool{
+	"memo":             true,
+	"forwardRef":       true,
+	"React.memo":       true,
+	"React.forwardRef": true,
+}
+
+// reactJSXNodes 表示 JSX 的节点类型
+var reactJSXNodes = map[string]bool{
+	"jsx_element":              true,
+	"jsx_self_closing_element": true,
+	"jsx_fragment":             true,
+}
+
+// annotateReactSymbol 识别 React 组件和 hook：组件是返回 JSX 的大写开头函数、memo/forwardRef 包装的函数
+// 或继承 Component 的类，hook 是 use 开头的函数；记录 props 类型和调用的 hooks。
+// typeNode 为变量的类型注解（如 React.FC<Props>），可以为 nil
+func (j *JSExtractor) annotateReactSymbol(symbol *models.Symbol, name string, node, typeNode *sitter.Node, content []byte) {
+	if node.Type() == "class_declaration" || node.Type() == "class" {
+		if props, ok := j.reactClassComponent(node, content); ok {
+			symbol.Kind = reactKindComponent
+			symbol.Props = props
+		}
+		return
+	}
+
+	fn, wrappers := j.unwrapReactComponent(node, content)
+	if fn == nil {
+		return
+	}
+	if name == "" {
+		if nameNode := fn.ChildByFieldName("name"); nameNode != nil {
+			name = nameNode.Content(content)
+		}
+	}
+
+	body := fn.ChildByFieldName("body")
+	switch {
+	case reactHookNameRegex.MatchString(name):
+		symbol.Kind = reactKindHook
+	case name != "" && name[0] >= 'A' && name[0] <= 'Z' && (len(wrappers) > 0 || j.containsJSX(body)):
+		symbol.Kind = reactKindComponent
+		symbol.Props = j.reactPropsType(fn, wrappers, typeNode, content)
+	default:
+		return
+	}
+	symbol.Hooks = j.collectHooks(body, content)
+}
+
+// unwrapReactComponent 返回函数节点以及包装它的 memo/forwardRef 调用（由外到内），不是函数时返回 nil
+func (j *JSExtractor) unwrapReactComponent(node *sitter.Node, content []byte) (*sitter.Node, []*sitter.Node) {
+	var wrappers []*sitter.Node
+	for node != nil && node.Type() == "call_expression" {
+		callee := node.ChildByFieldName("function")
+		arguments := node.ChildByFieldName("arguments")
+		if callee == nil || arguments == nil || arguments.NamedChildCount() == 0 || !reactWrappers[callee.Content(content)] {
+			return nil, nil
+		}
+		wrappers = append(wrappers, node)
+		node = arguments.NamedChild(0)
+	}
+
+	switch node.Type() {
+	case "function_declaration", "generator_function_declaration":
+		return node, wrappers
+	}
+	if jsFunctionValues[node.Type()] {
+		return node, wrappers
+	}
+	return nil, nil
+}
+
+// reactClassComponent 检查类是否继承 React.Component/PureComponent，返回第一个类型参数作为 props 类型
+func (j *JSExtractor) reactClassComponent(node *sitter.Node, content []byte) (string, bool) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		heritage := node.NamedChild(i)
+		if heritage.Type() != "class_heritage" {
+			continue
+		}
+
+		// TypeScript 使用 extends_clause，JavaScript 直接包含父类表达式
+		base := heritage.NamedChild(0)
+		var typeArguments *sitter.Node
+		if base != nil && base.Type() == "extends_clause" {
+			typeArguments = base.ChildByFieldName("type_arguments")
+			base = base.ChildByFieldName("value")
+		}
+		if base == nil || !reactComponentBaseRegex.MatchString(base.Content(content)) {
+			return "", false
+		}
+		if typeArguments != nil && typeArguments.NamedChildCount() > 0 {
+			return j.cleanText(typeArguments.NamedChild(0).Content(content)), true
+		}
+		return "", true
+	}
+	return "", false
+}
+
+// reactPropsType 获取组件的 props 类型：第一个参数的类型注解或解构模式、forwardRef 的第二个类型参数、
+// 或变量类型注解（React.FC<Props>）的类型参数
+func (j *JSExtractor) reactPropsType(fn *sitter.Node, wrappers []*sitter.Node, typeNode *sitter.Node, content []byte) string {
+	pattern := ""
+	if parameters := fn.ChildByFieldName("parameters"); parameters != nil && parameters.NamedChildCount() > 0 {
+		param := parameters.NamedChild(0)
+		if param.Type() == "required_parameter" || param.Type() == "optional_parameter" {
+			if paramType := param.ChildByFieldName("type"); paramType != nil {
+				return j.cleanText(strings.TrimPrefix(paramType.Content(content), ":"))
+			}
+			param = param.ChildByFieldName("pattern")
+		}
+		if param != nil && param.Type() == "object_pattern" {
+			pattern = j.cleanText(param.Content(content))
+		}
+	}
+
+	for _, wrapper := range wrappers {
+		typeArguments := wrapper.ChildByFieldName("type_arguments")
+		callee := wrapper.ChildByFieldName("function").Content(content)
+		if typeArguments != nil && strings.HasSuffix(callee, "forwardRef") && typeArguments.NamedChildCount() > 1 {
+			return j.cleanText(typeArguments.NamedChild(1).Content(content))
+		}
+	}
+
+	if typeNode != nil {
+		if typeArguments := j.findChild(typeNode, "type_arguments"); typeArguments != nil && typeArguments.NamedChildCount() > 0 {
+			return j.cleanText(typeArguments.NamedChild(0).Content(content))
+		}
+	}
+
+	return pattern
+}
+
+// containsJSX 检查节点子树中是否包含 JSX
+func (j *JSExtractor) containsJSX(node *sitter.Node) bool {
+	if node == nil {
+		return false
+	}
+	if reactJSXNodes[node.Type()] {
+		return true
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if j.containsJSX(node.NamedChild(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectHooks 按调用顺序收集子树中调用的 hooks（去重，React.useState 记为 useState）
+func (j *JSExtractor) collectHooks(node *sitter.Node, content []byte) []string {
+	var hooks []string
+	seen := make(map[string]bool)
+
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n.Type() == "call_expression" {
+			if callee := n.ChildByFieldName("function"); callee != nil {
+				name := strings.TrimPrefix(callee.Content(content), "React.")
+				if reactHookNameRegex.MatchString(name) && isIdentifier(name) && !seen[name] {
+					seen[name] = true
+					hooks = append(hooks, name)
+				}
+			}
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	if node != nil {
+		walk(node)
+	}
+	return hooks
+}
+
+// findChild 在子树中查找第一个指定类型的节点
+func (j *JSExtractor) findChild(node *sitter.Node, nodeType string) *sitter.Node {
+	if node.Type() == nodeType {
+		return node
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if found := j.findChild(node.NamedChild(i), nodeType); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// isIdentifier 检查字符串是否为标识符（只包含字母、数字、_ 和 $）
+func isIdentifier(name string) bool {
+	for i := 0; i < len(name); i++ {
+		if !isWordChar(name[i]) && name[i] != '$' {
+			return false
+		}
+	}
+	return name != ""
+}
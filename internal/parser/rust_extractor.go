@@ -7,6 +7,26 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+// rustTypeItems 可以带有 impl 块的类型定义
+var rustTypeItems = map[string]bool{
+	"struct_item": true,
+	"enum_item":   true,
+	"union_item":  true,
+	"type_item":   true,
+}
+
+// rustItemModifiers 条目上记录为修饰符的关键字（unsafe impl/trait、static mut）
+var rustItemModifiers = map[string]bool{"unsafe": true, "mut": true}
+
+// rustFunctionModifiers 函数限定符中记录为修饰符的关键字（extern "C" 单独处理）
+var rustFunctionModifiers = map[string]bool{"default": true, "async": true, "const": true, "unsafe": true}
+
+// rustImpl 一个 impl 块中的方法和实现的 trait
+type rustImpl struct {
+	trait   string
+	methods []models.Symbol
+}
+
 // RustExtractor Rust语言提取器
 type RustExtractor struct {
 	BaseExtractor
@@ -33,54 +53,41 @@ func (r *RustExtractor) GetQueries() []string {
 
 // ExtractPrototype 提取Rust函数/结构体原型
 func (r *RustExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
-	nodeType := node.Type()
-
-	// 对于函数定义，提取函数签名（不包含函数体）
-	if nodeType == "function_item" {
+	switch node.Type() {
+	case "function_item":
+		// 函数签名（不包含函数体）
 		return r.extractFunctionPrototype(node, content, r.IsFunctionBodyNode)
-	}
 
-	// 对于结构体，返回完整内容
-	if nodeType == "struct_item" {
-		return r.extractStructPrototype(node, content)
-	}
+	case "struct_item", "enum_item", "union_item", "trait_item", "impl_item", "mod_item", "foreign_mod_item":
+		// 带有花括号体的声明只保留体之前的部分，元组结构体和 mod foo; 保留完整声明
+		if body := node.ChildByFieldName("body"); body != nil && body.Type() != "ordered_field_declaration_list" {
+			return r.cleanText(string(content[node.StartByte():body.StartByte()]))
+		}
+
+	case "macro_definition":
+		if name := node.ChildByFieldName("name"); name != nil {
+			return "macro_rules! " + name.Content(content)
+		}
 
-	// 对于impl块，返回完整内容
-	if nodeType == "impl_item" {
-		return r.extractImplPrototype(node, content)
+	case "const_item", "static_item":
+		return truncateConfigValue(strings.TrimSuffix(r.extractFullNode(node, content), ";"))
 	}
 
-	return r.extractFullNode(node, content)
+	return strings.TrimSuffix(r.extractFullNode(node, content), ";")
 }
 
-// ExtractMethods 提取Rust impl块内部的方法
+// ExtractMethods 提取Rust impl块或trait内部的方法
 func (r *RustExtractor) ExtractMethods(implNode *sitter.Node, content []byte) []models.Symbol {
 	var methods []models.Symbol
 
-	childCount := int(implNode.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := implNode.Child(i)
-		if child == nil {
-			continue
-		}
-
-		childType := child.Type()
-		// 查找impl体
-		if childType == "declaration_list" || childType == "{" {
-			// 遍历impl体中的方法
-			bodyChildCount := int(child.ChildCount())
-			for j := 0; j < bodyChildCount; j++ {
-				bodyChild := child.Child(j)
-				if bodyChild == nil {
-					continue
-				}
-
-				bodyChildType := bodyChild.Type()
-				if bodyChildType == "function_item" {
-					method := r.createMethodSymbol(bodyChild, content)
-					methods = append(methods, method)
-				}
-			}
+	body := implNode.ChildByFieldName("body")
+	if body == nil {
+		return methods
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		if child.Type() == "function_item" || child.Type() == "function_signature_item" {
+			methods = append(methods, r.createSymbol(child, content))
 		}
 	}
 
@@ -116,140 +123,416 @@ func (r *RustExtractor) IsInsideClass(node *sitter.Node) bool {
 	return false
 }
 
-// ExtractComments 提取Rust注释
+// ExtractComments 提取Rust注释（/// 和 /** */ 文档注释的第一段，跳过中间的属性）
 func (r *RustExtractor) ExtractComments(node *sitter.Node, content []byte) string {
 	return r.extractRustComments(node, content)
 }
 
-// extractStructPrototype 提取Rust结构体原型
-func (r *RustExtractor) extractStructPrototype(node *sitter.Node, content []byte) string {
-	childCount := int(node.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := node.Child(i)
-		if child == nil {
-			continue
-		}
+// ExtractSymbols 按模块层级提取条目：impl 块中的方法归到对应类型的 Methods 中，
+// 实现的 trait 记录在类型的 implements 字段中，类型不在本文件中定义时保留 impl 块
+func (r *RustExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	impls := make(map[string][]rustImpl)
+	defined := make(map[string]bool)
+	r.collectImpls(root, content, impls, defined)
+	return r.extractItems(root, content, impls, defined)
+}
 
-		childType := child.Type()
-		// 查找结构体体开始位置
-		if childType == "field_declaration_list" || childType == "{" {
-			prototype := string(content[node.StartByte():child.StartByte()])
-			return strings.TrimSpace(prototype)
+// ExtractFilePurpose 提取文件开头 //! 模块文档的第一段
+func (r *RustExtractor) ExtractFilePurpose(content []byte) string {
+	var docLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "//!") {
+			if trimmed == "" && len(docLines) == 0 {
+				continue
+			}
+			break
 		}
+		docLines = append(docLines, strings.TrimPrefix(trimmed, "//!"))
 	}
-	return ""
+	return rustDocSummary(docLines)
 }
 
-// extractImplPrototype 提取Rust impl块原型
-func (r *RustExtractor) extractImplPrototype(node *sitter.Node, content []byte) string {
-	childCount := int(node.ChildCount())
-	for i := 0; i < childCount; i++ {
-		child := node.Child(i)
-		if child == nil {
-			continue
+// collectImpls 收集所有 impl 块（按类型名称分组）以及文件中定义的类型名称
+func (r *RustExtractor) collectImpls(parent *sitter.Node, content []byte, impls map[string][]rustImpl, defined map[string]bool) {
+	for i := 0; i < int(parent.NamedChildCount()); i++ {
+		node := parent.NamedChild(i)
+		switch {
+		case rustTypeItems[node.Type()]:
+			if name := node.ChildByFieldName("name"); name != nil {
+				defined[name.Content(content)] = true
+			}
+		case node.Type() == "impl_item":
+			typeName := r.implTypeName(node, content)
+			if typeName == "" {
+				continue
+			}
+			impl := rustImpl{methods: r.ExtractMethods(node, content)}
+			if trait := node.ChildByFieldName("trait"); trait != nil {
+				impl.trait = r.cleanText(trait.Content(content))
+				inheritVisibility(impl.methods, visibilityPublic)
+			}
+			impls[typeName] = append(impls[typeName], impl)
+		case node.Type() == "mod_item":
+			if body := node.ChildByFieldName("body"); body != nil {
+				r.collectImpls(body, content, impls, defined)
+			}
 		}
+	}
+}
+
+// extractItems 提取一组条目（源文件或内联模块体）中的符号
+func (r *RustExtractor) extractItems(parent *sitter.Node, content []byte, impls map[string][]rustImpl, defined map[string]bool) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(parent.NamedChildCount()); i++ {
+		node := parent.NamedChild(i)
+		nodeType := node.Type()
+
+		switch nodeType {
+		case "function_item", "function_signature_item", "macro_definition",
+			"const_item", "static_item", "type_item":
+			symbol := r.createSymbol(node, content)
+			if nodeType == "function_item" && r.hasTestAttribute(node, content) {
+				symbol.Kind = "test"
+			}
+			if nodeType == "type_item" {
+				r.attachImpls(&symbol, node, content, impls)
+			}
+			symbols = append(symbols, symbol)
 
-		childType := child.Type()
-		// 查找impl体开始位置
-		if childType == "declaration_list" || childType == "{" {
-			prototype := string(content[node.StartByte():child.StartByte()])
-			return strings.TrimSpace(prototype)
+		case "struct_item", "union_item":
+			symbol := r.createSymbol(node, content)
+			if body := node.ChildByFieldName("body"); body != nil && body.Type() == "field_declaration_list" {
+				symbol.Children = r.extractMembers(body, content, "field_declaration")
+			}
+			r.attachImpls(&symbol, node, content, impls)
+			symbols = append(symbols, symbol)
+
+		case "enum_item":
+			symbol := r.createSymbol(node, content)
+			if body := node.ChildByFieldName("body"); body != nil {
+				symbol.Children = r.extractMembers(body, content, "enum_variant")
+				inheritVisibility(symbol.Children, symbol.Visibility)
+			}
+			r.attachImpls(&symbol, node, content, impls)
+			symbols = append(symbols, symbol)
+
+		case "trait_item":
+			symbol := r.createSymbol(node, content)
+			symbol.Methods = r.ExtractMethods(node, content)
+			if body := node.ChildByFieldName("body"); body != nil {
+				symbol.Children = r.extractMembers(body, content, "associated_type", "const_item")
+			}
+			// trait 的成员与 trait 本身的可见性相同
+			inheritVisibility(symbol.Methods, symbol.Visibility)
+			inheritVisibility(symbol.Children, symbol.Visibility)
+			symbols = append(symbols, symbol)
+
+		case "impl_item":
+			// 类型在本文件中定义时方法已归到类型下
+			if defined[r.implTypeName(node, content)] {
+				continue
+			}
+			symbol := r.createSymbol(node, content)
+			symbol.Methods = r.ExtractMethods(node, content)
+			if node.ChildByFieldName("trait") != nil {
+				inheritVisibility(symbol.Methods, visibilityPublic)
+			}
+			symbols = append(symbols, symbol)
+
+		case "mod_item", "foreign_mod_item":
+			symbol := r.createSymbol(node, content)
+			if body := node.ChildByFieldName("body"); body != nil {
+				symbol.Children = r.extractItems(body, content, impls, defined)
+			}
+			symbols = append(symbols, symbol)
 		}
 	}
-	return ""
+
+	return symbols
 }
 
-// createMethodSymbol 创建方法符号
-func (r *RustExtractor) createMethodSymbol(node *sitter.Node, content []byte) models.Symbol {
-	start := node.StartPoint()
-	end := node.EndPoint()
+// extractMembers 提取结构体字段、枚举变体或 trait 关联项等子成员
+func (r *RustExtractor) extractMembers(body *sitter.Node, content []byte, memberTypes ...string) []models.Symbol {
+	var members []models.Symbol
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		for _, memberType := range memberTypes {
+			if child.Type() == memberType {
+				members = append(members, r.createSymbol(child, content))
+			}
+		}
+	}
+	return members
+}
 
-	prototype := r.extractFunctionPrototype(node, content, r.IsFunctionBodyNode)
+// attachImpls 将类型的 impl 块方法和实现的 trait 添加到类型符号上
+func (r *RustExtractor) attachImpls(symbol *models.Symbol, node *sitter.Node, content []byte, impls map[string][]rustImpl) {
+	name := node.ChildByFieldName("name")
+	if name == nil {
+		return
+	}
+	for _, impl := range impls[name.Content(content)] {
+		symbol.Methods = append(symbol.Methods, impl.methods...)
+		if impl.trait != "" {
+			symbol.Implements = append(symbol.Implements, impl.trait)
+		}
+	}
+}
 
-	return models.Symbol{
-		Prototype: prototype,
-		Purpose:   r.extractRustComments(node, content),
-		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
+// implTypeName 获取 impl 块的目标类型名称（去除泛型参数、引用和路径）
+func (r *RustExtractor) implTypeName(node *sitter.Node, content []byte) string {
+	typeNode := node.ChildByFieldName("type")
+	for typeNode != nil {
+		switch typeNode.Type() {
+		case "type_identifier":
+			return typeNode.Content(content)
+		case "generic_type", "reference_type", "pointer_type":
+			typeNode = typeNode.ChildByFieldName("type")
+		case "scoped_type_identifier":
+			typeNode = typeNode.ChildByFieldName("name")
+		default:
+			return ""
+		}
 	}
+	return ""
 }
 
-// extractRustComments 提取Rust注释（支持文档注释）
-func (r *RustExtractor) extractRustComments(node *sitter.Node, content []byte) string {
-	startPoint := node.StartPoint()
-	startRow := int(startPoint.Row)
-	lines := strings.Split(string(content), "\n")
+// createSymbol 创建以节点范围为行号范围的符号
+func (r *RustExtractor) createSymbol(node *sitter.Node, content []byte) models.Symbol {
+	symbol := models.Symbol{
+		Prototype:  r.ExtractPrototype(node, content),
+		Purpose:    r.extractRustComments(node, content),
+		Range:      []int{int(node.StartPoint().Row) + 1, int(node.EndPoint().Row) + 1},
+		Visibility: r.extractVisibility(node, content),
+		Modifiers:  r.extractModifiers(node, content),
+		TypeParams: r.extractTypeParams(node, content),
+		Params:     r.extractParams(node.ChildByFieldName("parameters"), content),
+	}
+	if returnType := node.ChildByFieldName("return_type"); returnType != nil {
+		symbol.Returns = []string{nodeText(returnType, content)}
+	}
+	return symbol
+}
 
-	var commentLines []string
-	inMultiLineComment := false
+// extractParams 解析函数参数：self 参数的类型写作 &Self、&mut Self，extern 函数的 ... 标记为可变参数
+func (r *RustExtractor) extractParams(list *sitter.Node, content []byte) []models.Param {
+	if list == nil {
+		return nil
+	}
 
-	for i := startRow - 1; i >= 0; i-- {
-		if i >= len(lines) {
-			continue
+	var params []models.Param
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		node := list.NamedChild(i)
+		switch node.Type() {
+		case "self_parameter":
+			param := models.Param{Name: "self", Type: fieldText(node, "type", content)}
+			if text := nodeText(node, content); param.Type == "" && strings.HasPrefix(text, "&") {
+				param.Type = strings.TrimSuffix(text, "self") + "Self"
+			}
+			params = append(params, param)
+		case "parameter":
+			params = append(params, models.Param{Name: fieldText(node, "pattern", content), Type: fieldText(node, "type", content)})
+		case "variadic_parameter":
+			params = append(params, models.Param{Name: fieldText(node, "pattern", content), Variadic: true})
 		}
+	}
+	return params
+}
 
-		line := strings.TrimSpace(lines[i])
-		if line == "" {
-			continue
+// extractTypeParams 解析生命周期、类型和 const 泛型参数，where 子句中对同名参数的约束合并到 Constraint
+func (r *RustExtractor) extractTypeParams(node *sitter.Node, content []byte) []models.TypeParam {
+	list := node.ChildByFieldName("type_parameters")
+	if list == nil {
+		return nil
+	}
+
+	var typeParams []models.TypeParam
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		if typeParam, ok := r.typeParam(list.NamedChild(i), content); ok {
+			typeParams = append(typeParams, typeParam)
 		}
+	}
 
-		// 检查文档注释结束
-		if strings.HasSuffix(line, "*/") {
-			inMultiLineComment = true
-			comment := strings.TrimSpace(strings.TrimSuffix(line, "*/"))
-			if comment != "" {
-				commentLines = append([]string{comment}, commentLines...)
-			}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		clause := node.NamedChild(i)
+		if clause.Type() != "where_clause" {
 			continue
 		}
-
-		// 检查文档注释中间行
-		if inMultiLineComment {
-			if strings.HasPrefix(line, "///") {
-				comment := strings.TrimSpace(strings.TrimPrefix(line, "///"))
-				if comment != "" {
-					commentLines = append([]string{comment}, commentLines...)
+		for j := 0; j < int(clause.NamedChildCount()); j++ {
+			predicate := clause.NamedChild(j)
+			name, bounds := fieldText(predicate, "left", content), fieldText(predicate, "bounds", content)
+			for k := range typeParams {
+				if typeParams[k].Name != name || bounds == "" {
+					continue
 				}
-				continue
-			} else if strings.HasPrefix(line, "/**") {
-				// 文档注释开始
-				comment := strings.TrimSpace(strings.TrimPrefix(line, "/**"))
-				if comment != "" {
-					commentLines = append([]string{comment}, commentLines...)
+				if typeParams[k].Constraint != "" {
+					typeParams[k].Constraint += " + "
 				}
-				inMultiLineComment = false
-				break
+				typeParams[k].Constraint += strings.TrimSpace(strings.TrimPrefix(bounds, ":"))
 			}
 		}
+	}
+	return typeParams
+}
+
+// typeParam 解析单个泛型参数：T: Clone 的约束为 Clone，const N: usize 的名称为 const N、约束为 usize
+func (r *RustExtractor) typeParam(node *sitter.Node, content []byte) (models.TypeParam, bool) {
+	switch node.Type() {
+	case "lifetime", "type_identifier":
+		return models.TypeParam{Name: nodeText(node, content)}, true
+	case "constrained_type_parameter":
+		bounds := strings.TrimSpace(strings.TrimPrefix(fieldText(node, "bounds", content), ":"))
+		return models.TypeParam{Name: fieldText(node, "left", content), Constraint: bounds}, true
+	case "const_parameter":
+		return models.TypeParam{Name: "const " + fieldText(node, "name", content), Constraint: fieldText(node, "type", content)}, true
+	case "optional_type_parameter":
+		name := node.ChildByFieldName("name")
+		if name == nil {
+			return models.TypeParam{}, false
+		}
+		typeParam, ok := r.typeParam(name, content)
+		typeParam.Default = fieldText(node, "default_type", content)
+		return typeParam, ok
+	}
+	return models.TypeParam{}, false
+}
+
+// extractVisibility 按 pub 修饰判断可见性：pub 为 public，pub(crate)、pub(super)、pub(in path) 为 internal，
+// 没有修饰为 private；带 #[macro_export] 的宏为 public。impl 块和 extern 块本身没有可见性
+func (r *RustExtractor) extractVisibility(node *sitter.Node, content []byte) string {
+	switch node.Type() {
+	case "impl_item", "foreign_mod_item":
+		return ""
+	case "macro_definition":
+		if r.hasAttribute(node, content, "#[macro_export]") {
+			return visibilityPublic
+		}
+	}
 
-		// 检查Rust文档注释 ///
-		if strings.HasPrefix(line, "///") {
-			comment := strings.TrimSpace(strings.TrimPrefix(line, "///"))
-			// 跳过空行和标题行
-			if comment != "" && !strings.HasPrefix(comment, "#") {
-				commentLines = append([]string{comment}, commentLines...)
-			}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "visibility_modifier" {
 			continue
 		}
+		switch strings.Join(strings.Fields(child.Content(content)), "") {
+		case "pub":
+			return visibilityPublic
+		case "pub(self)":
+			return visibilityPrivate
+		default:
+			return visibilityInternal
+		}
+	}
+	return visibilityPrivate
+}
 
-		// 检查普通注释 //
-		if strings.HasPrefix(line, "//") && !strings.HasPrefix(line, "///") {
-			comment := strings.TrimSpace(strings.TrimPrefix(line, "//"))
-			if comment != "" {
-				commentLines = append([]string{comment}, commentLines...)
-			}
+// extractModifiers 提取 async、const、unsafe、extern 等函数限定符，以及 unsafe impl、static mut
+func (r *RustExtractor) extractModifiers(node *sitter.Node, content []byte) []string {
+	modifiers := keywordModifiers(node, content, rustItemModifiers)
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "function_modifiers" {
 			continue
 		}
+		for j := 0; j < int(child.ChildCount()); j++ {
+			modifier := child.Child(j)
+			if modifier.Type() == "extern_modifier" {
+				modifiers = appendModifier(modifiers, "extern")
+			} else if word := modifier.Content(content); rustFunctionModifiers[word] {
+				modifiers = appendModifier(modifiers, word)
+			}
+		}
+	}
+	return modifiers
+}
+
+// hasAttribute 检查条目前是否有指定的属性
+func (r *RustExtractor) hasAttribute(node *sitter.Node, content []byte, attribute string) bool {
+	for prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "attribute_item"; prev = prev.PrevNamedSibling() {
+		if strings.TrimSpace(prev.Content(content)) == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTestAttribute 检查函数前是否有 #[test] 或 #[tokio::test] 等测试属性
+func (r *RustExtractor) hasTestAttribute(node *sitter.Node, content []byte) bool {
+	for prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "attribute_item"; prev = prev.PrevNamedSibling() {
+		attribute := strings.TrimSpace(prev.Content(content))
+		if attribute == "#[test]" || strings.HasSuffix(attribute, "::test]") {
+			return true
+		}
+	}
+	return false
+}
+
+// extractRustComments 提取条目上方紧邻的注释，优先使用 /// 和 /** */ 文档注释，
+// 跳过条目与注释之间的 #[...] 属性，只保留第一段
+func (r *RustExtractor) extractRustComments(node *sitter.Node, content []byte) string {
+	var docLines, commentLines []string
+	row := node.StartPoint().Row
+
+	for prev := node.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		endRow := prev.EndPoint().Row
+		if prev.EndPoint().Column == 0 && endRow > prev.StartPoint().Row {
+			endRow--
+		}
+		if endRow+1 < row {
+			break
+		}
+		row = prev.StartPoint().Row
 
-		// 如果遇到非注释行，停止查找
-		if !strings.HasPrefix(line, "//") && !strings.HasPrefix(line, "/**") && !strings.HasPrefix(line, "///") && line != "" {
+		if prev.Type() == "attribute_item" {
+			continue
+		}
+		if prev.Type() != "line_comment" && prev.Type() != "block_comment" {
 			break
 		}
+
+		text := strings.TrimSpace(prev.Content(content))
+		switch {
+		case strings.HasPrefix(text, "//!") || strings.HasPrefix(text, "/*!"):
+			// 内部文档注释属于外层模块
+			return rustDocSummary(r.pickComments(docLines, commentLines))
+		case strings.HasPrefix(text, "///") && !strings.HasPrefix(text, "////"):
+			docLines = append([]string{strings.TrimPrefix(text, "///")}, docLines...)
+		case strings.HasPrefix(text, "/**"):
+			var blockLines []string
+			for _, line := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(text, "/**"), "*/"), "\n") {
+				blockLines = append(blockLines, strings.TrimPrefix(strings.TrimSpace(line), "*"))
+			}
+			docLines = append(blockLines, docLines...)
+		case strings.HasPrefix(text, "//"):
+			commentLines = append([]string{strings.TrimPrefix(text, "//")}, commentLines...)
+		}
 	}
 
-	// 如果有收集到的多行注释，合并它们
-	if len(commentLines) > 0 {
-		return strings.Join(commentLines, " ")
+	return rustDocSummary(r.pickComments(docLines, commentLines))
+}
+
+// pickComments 有文档注释时使用文档注释，否则使用普通注释
+func (r *RustExtractor) pickComments(docLines, commentLines []string) []string {
+	if len(docLines) > 0 {
+		return docLines
 	}
+	return commentLines
+}
 
-	return ""
+// rustDocSummary 返回文档注释的第一段（第一个空行之前的内容）
+func rustDocSummary(lines []string) string {
+	var summary []string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if len(summary) > 0 {
+				break
+			}
+			continue
+		}
+		summary = append(summary, trimmed)
+	}
+	return strings.Join(summary, " ")
 }
@@ -0,0 +1,518 @@
+package parser
+
+import (
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+// sfcScriptSyntax 脚本区块中的注释和字符串语法
+var sfcScriptSyntax = textSyntax{
+	lineComments:  []string{"//"},
+	blockComments: [][2]string{{"/*", "*/"}},
+	quotes:        "\"'`",
+}
+
+var (
+	// sfcBlockOpenRegex 匹配行首的顶层区块开始标签
+	sfcBlockOpenRegex = regexp.MustCompile(`(?m)^<(script|template|style)(\s[^>]*)?>`)
+
+	// sfcSkippedRegionRegex 匹配 HTML 注释和 <svelte:head>，其中的 <script>/<style> 不是组件的顶层区块
+	sfcSkippedRegionRegex = regexp.MustCompile(`<!--[\s\S]*?-->|<svelte:head[\s>][\s\S]*?</svelte:head>`)
+
+	// sfcHTMLCommentRegex 匹配 HTML 注释
+	sfcHTMLCommentRegex = regexp.MustCompile(`<!--[\s\S]*?-->`)
+
+	// sfcLeadingCommentRegex 匹配文件开头的 HTML 注释
+	sfcLeadingCommentRegex = regexp.MustCompile(`^\s*<!--([\s\S]*?)-->`)
+
+	// sfcLangAttrRegex 匹配 lang="ts" 等属性
+	sfcLangAttrRegex = regexp.MustCompile(`\blang\s*=\s*["']?(\w+)`)
+
+	// sfcOptionKeyRegex 匹配对象字面量成员开头的键 key:
+	sfcOptionKeyRegex = regexp.MustCompile(`^(\w+)\s*:\s*`)
+
+	// sfcQuotedNameRegex 匹配 name 选项的字符串值
+	sfcQuotedNameRegex = regexp.MustCompile(`^["']([\w-]+)["']`)
+
+	// sfcDefineComponentRegex 匹配 export default 之后的 defineComponent(
+	sfcDefineComponentRegex = regexp.MustCompile(`^defineComponent\s*\(`)
+
+	// sfcExportFunctionRegex 匹配导出的函数或常量
+	sfcExportFunctionRegex = regexp.MustCompile(`(?m)^[ \t]*export\s+((async\s+)?function\s*\*?\s*\w+\s*\([^)]*\)|const\s+\w+)`)
+
+	// svelteExportLetRegex 匹配 Svelte 组件属性 export let name
+	svelteExportLetRegex = regexp.MustCompile(`(?m)^[ \t]*export\s+let\s+[^;\n]+`)
+
+	// svelteRunePropsRegex 匹配解构声明的开头 let { / const {
+	svelteRunePropsRegex = regexp.MustCompile(`\b(?:let|const)\s*\{`)
+
+	// sveltePropsAssignRegex 匹配解构模式之后的 (: 类型)? = $props()
+	sveltePropsAssignRegex = regexp.MustCompile(`^\s*(?::[^=;]*)?=\s*\$props\(\)`)
+
+	// svelteDispatchRegex 匹配 Svelte 事件派发 dispatch('name')
+	svelteDispatchRegex = regexp.MustCompile(`\bdispatch\(\s*["']([\w:.-]+)["']`)
+
+	// emitSignatureRegex 匹配类型声明形式的事件签名 (e: 'change', ...)
+	emitSignatureRegex = regexp.MustCompile(`^\(\s*\w+\s*:\s*["']([\w:.-]+)["']`)
+)
+
+// sfcBlock 单文件组件中的顶层区块
+type sfcBlock struct {
+	tag          string // script / template / style
+	openTag      string // 开始标签，如 <script setup lang="ts">
+	startLine    int    // 开始标签所在行（从1开始）
+	endLine      int    // 结束标签所在行（从1开始）
+	content      string // 区块内容
+	masked       string // 屏蔽注释和字符串后的区块内容，用于括号匹配和查找
+	contentStart int    // 区块内容在文件中的字节偏移
+}
+
+// parseSFC 解析 Vue/Svelte 单文件组件：拆分区块、提取脚本符号并生成组件元数据
+func (p *TreeSitterParser) parseSFC(filePath string, content []byte, langName string) ([]models.Symbol, string, error) {
+	text := string(content)
+	blocks := splitSFCBlocks(text, langName)
+
+	var symbols []models.Symbol
+	var scriptBlocks []sfcBlock
+	for _, block := range blocks {
+		symbol := models.Symbol{
+			Prototype: block.openTag,
+			Range:     []int{block.startLine, block.endLine},
+		}
+
+		if block.tag == "script" {
+			scriptLang := sfcScriptLang(block.openTag)
+
+			scriptSymbols, err := p.parseSymbols(filePath, []byte(block.content), scriptLang)
+			if err != nil {
+				return nil, "", err
+			}
+			symbol.Children = shiftSymbolRanges(scriptSymbols, lineOfOffset(text, block.contentStart)-1)
+			scriptBlocks = append(scriptBlocks, block)
+		}
+
+		symbols = append(symbols, symbol)
+	}
+
+	// Svelte 的模板是脚本和样式之外的全部内容
+	if langName == langSvelte {
+		if markup, ok := svelteMarkupRange(text, blocks); ok {
+			symbols = append(symbols, models.Symbol{Prototype: "<markup>", Range: markup})
+		}
+	}
+	// 区块和模板按源码顺序输出
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return symbols[i].Range[0] < symbols[j].Range[0]
+	})
+
+	component := models.Symbol{
+		Prototype: "component " + sfcComponentName(filePath, scriptBlocks),
+		Range:     []int{1, lineOfOffset(text, len(strings.TrimRight(text, "\n")))},
+		Children:  extractSFCMetadata(text, scriptBlocks, langName),
+	}
+
+	purpose := ""
+	if match := sfcLeadingCommentRegex.FindStringSubmatch(text); match != nil {
+		purpose = strings.Join(strings.Fields(match[1]), " ")
+	}
+
+	return append([]models.Symbol{component}, symbols...), purpose, nil
+}
+
+// sfcScriptLang 根据脚本区块的 lang 属性选择语法：ts 使用 TypeScript，tsx 使用 TSX，否则为 JavaScript
+func sfcScriptLang(openTag string) string {
+	match := sfcLangAttrRegex.FindStringSubmatch(openTag)
+	if match == nil {
+		return langJavaScript
+	}
+	switch strings.ToLower(match[1]) {
+	case "ts", "typescript":
+		return langTypeScript
+	case "tsx":
+		return langTSX
+	default:
+		return langJavaScript
+	}
+}
+
+// splitSFCBlocks 按行首的开始/结束标签拆分顶层区块，跳过 HTML 注释中的标签；
+// Svelte 的 <template> 属于模板内容，不作为区块
+func splitSFCBlocks(text, langName string) []sfcBlock {
+	var blocks []sfcBlock
+	searchFrom := 0
+	skipped := sfcSkippedRegionRegex.FindAllStringIndex(text, -1)
+
+	for _, loc := range sfcBlockOpenRegex.FindAllStringSubmatchIndex(text, -1) {
+		if loc[0] < searchFrom || insideRegion(skipped, loc[0]) {
+			continue
+		}
+
+		tag := text[loc[2]:loc[3]]
+		if langName == langSvelte && tag == "template" {
+			continue
+		}
+		openTag := strings.Join(strings.Fields(text[loc[0]:loc[1]]), " ")
+
+		// 模板内部可以嵌套 <template>，因此结束标签需要位于行首
+		closeRegex := regexp.MustCompile(`(?m)^</` + tag + `>`)
+		if tag != "template" {
+			closeRegex = regexp.MustCompile(`</` + tag + `>`)
+		}
+		closeLoc := closeRegex.FindStringIndex(text[loc[1]:])
+		if closeLoc == nil {
+			continue
+		}
+		closeStart := loc[1] + closeLoc[0]
+
+		blocks = append(blocks, sfcBlock{
+			tag:          tag,
+			openTag:      openTag,
+			startLine:    lineOfOffset(text, loc[0]),
+			endLine:      lineOfOffset(text, closeStart),
+			content:      text[loc[1]:closeStart],
+			masked:       maskSource(text[loc[1]:closeStart], sfcScriptSyntax),
+			contentStart: loc[1],
+		})
+		searchFrom = loc[1] + closeLoc[1]
+	}
+
+	return blocks
+}
+
+// insideRegion 判断偏移是否落在某个区间内
+func insideRegion(regions [][]int, offset int) bool {
+	for _, region := range regions {
+		if offset > region[0] && offset < region[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// svelteMarkupRange 计算 Svelte 组件中脚本和样式之外的模板范围
+func svelteMarkupRange(text string, blocks []sfcBlock) ([]int, bool) {
+	lines := strings.Split(text, "\n")
+	inBlock := make([]bool, len(lines)+1)
+	for _, block := range blocks {
+		for line := block.startLine; line <= block.endLine && line <= len(lines); line++ {
+			inBlock[line] = true
+		}
+	}
+	// 整行都在 HTML 注释中的行不算模板
+	for _, loc := range sfcHTMLCommentRegex.FindAllStringIndex(text, -1) {
+		first, last := lineOfOffset(text, loc[0]), lineOfOffset(text, loc[1])
+		if strings.TrimSpace(text[strings.LastIndex(text[:loc[0]], "\n")+1:loc[0]]) != "" {
+			first++
+		}
+		if rest := text[loc[1]:]; strings.TrimSpace(strings.SplitN(rest, "\n", 2)[0]) != "" {
+			last--
+		}
+		for line := first; line <= last && line <= len(lines); line++ {
+			inBlock[line] = true
+		}
+	}
+
+	start, end := 0, 0
+	for i, line := range lines {
+		lineNumber := i + 1
+		trimmed := strings.TrimSpace(line)
+		if inBlock[lineNumber] || trimmed == "" {
+			continue
+		}
+		if start == 0 {
+			start = lineNumber
+		}
+		end = lineNumber
+	}
+
+	if start == 0 {
+		return nil, false
+	}
+	return []int{start, end}, true
+}
+
+// sfcComponentName 获取组件名称：优先使用 name 选项，否则使用文件名
+func sfcComponentName(filePath string, scriptBlocks []sfcBlock) string {
+	for _, block := range scriptBlocks {
+		if idx := strings.Index(block.masked, "defineOptions("); idx >= 0 {
+			if name := sfcNameOption(block, skipSpace(block.masked, idx+len("defineOptions("))); name != "" {
+				return name
+			}
+		}
+		if name := sfcNameOption(block, sfcOptionsObject(block)); name != "" {
+			return name
+		}
+	}
+
+	base := filepath.Base(filePath)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// sfcNameOption 读取 open 处对象字面量中顶层的 name 选项，忽略 data()、props 等嵌套对象中的 name
+func sfcNameOption(block sfcBlock, open int) string {
+	value := sfcOptionValue(block, open, "name")
+	if value < 0 {
+		return ""
+	}
+	if match := sfcQuotedNameRegex.FindStringSubmatch(block.content[value:]); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// sfcOptionsObject 返回 export default {...} 或 export default defineComponent({...}) 中选项对象左花括号的偏移，
+// 没有选项对象时返回 -1
+func sfcOptionsObject(block sfcBlock) int {
+	idx := strings.Index(block.masked, "export default")
+	if idx < 0 {
+		return -1
+	}
+	pos := skipSpace(block.masked, idx+len("export default"))
+	if loc := sfcDefineComponentRegex.FindStringIndex(block.masked[pos:]); loc != nil {
+		pos = skipSpace(block.masked, pos+loc[1])
+	}
+	if pos < len(block.masked) && block.masked[pos] == '{' {
+		return pos
+	}
+	return -1
+}
+
+// sfcOptionValue 在 open 处的对象字面量中查找顶层选项 option，返回其值的起始偏移；
+// 嵌套对象（如 components、methods 中）的同名键不算，找不到时返回 -1
+func sfcOptionValue(block sfcBlock, open int, option string) int {
+	if open < 0 || open >= len(block.masked) || block.masked[open] != '{' {
+		return -1
+	}
+	end := matchBrace(block.masked, open)
+	if end < 0 {
+		return -1
+	}
+	for _, segment := range splitTopLevelCommas(block.masked, open+1, end) {
+		start := skipSpace(block.masked, segment[0])
+		match := sfcOptionKeyRegex.FindStringSubmatchIndex(block.masked[start:segment[1]])
+		if match != nil && block.masked[start+match[2]:start+match[3]] == option {
+			return start + match[1]
+		}
+	}
+	return -1
+}
+
+// extractSFCMetadata 提取组件的 props、emits 和导出函数，分别作为分组符号
+func extractSFCMetadata(text string, scriptBlocks []sfcBlock, langName string) []models.Symbol {
+	// 每个脚本区块单独分组，避免分组范围跨越区块之间的模板和样式
+	var metadata []models.Symbol
+	for _, block := range scriptBlocks {
+		var props, emits, exports []models.Symbol
+		if langName == langSvelte {
+			props = extractSvelteProps(text, block)
+			emits = extractSvelteEmits(text, block)
+		} else {
+			props = extractVueMembers(text, block, "defineProps", "props")
+			emits = extractVueMembers(text, block, "defineEmits", "emits")
+			exports = extractVueMembers(text, block, "defineExpose", "")
+		}
+		exports = append(exports, extractSFCExports(text, block)...)
+
+		for _, group := range []struct {
+			name    string
+			members []models.Symbol
+		}{
+			{"props", props},
+			{"emits", emits},
+			{"exports", exports},
+		} {
+			if len(group.members) == 0 {
+				continue
+			}
+			metadata = append(metadata, models.Symbol{
+				Prototype: group.name,
+				Range:     spanRange(group.members),
+				Children:  group.members,
+			})
+		}
+	}
+
+	return metadata
+}
+
+// extractVueMembers 从 defineXxx 宏调用或组件选项（props:/emits:）中提取成员
+func extractVueMembers(text string, block sfcBlock, macro, option string) []models.Symbol {
+	script := block.masked
+
+	if idx := strings.Index(script, macro); idx >= 0 {
+		pos := idx + len(macro)
+		// 类型声明形式：defineProps<{...}>() / defineProps<Props>()
+		if pos < len(script) && script[pos] == '<' {
+			end := matchAngle(script, pos)
+			if end > pos {
+				typeArg := strings.TrimSpace(script[pos+1 : end])
+				typeStart := pos + 1 + strings.Index(script[pos+1:end], typeArg)
+				if !strings.HasPrefix(typeArg, "{") {
+					typeStart = findTypeDeclaration(script, typeArg)
+				}
+				if typeStart >= 0 {
+					return extractObjectMembers(text, block, typeStart)
+				}
+			}
+		}
+		// 运行时声明形式：defineProps({...}) / defineProps([...])
+		if open := strings.Index(script[pos:], "("); open >= 0 {
+			return extractObjectMembers(text, block, pos+open+1)
+		}
+	}
+
+	if option == "" {
+		return nil
+	}
+	// 只取组件选项对象的顶层选项，忽略嵌套对象中的同名键
+	if value := sfcOptionValue(block, sfcOptionsObject(block), option); value >= 0 {
+		return extractObjectMembers(text, block, value)
+	}
+	return nil
+}
+
+// findTypeDeclaration 查找 interface/type 声明的类型体起始位置
+func findTypeDeclaration(script, typeName string) int {
+	declRegex := regexp.MustCompile(`\b(interface\s+` + regexp.QuoteMeta(typeName) + `\b[^{]*|type\s+` + regexp.QuoteMeta(typeName) + `\s*=\s*)\{`)
+	loc := declRegex.FindStringIndex(script)
+	if loc == nil {
+		return -1
+	}
+	return loc[1] - 1
+}
+
+// extractObjectMembers 提取从 start 开始的对象/数组/类型字面量的顶层成员
+func extractObjectMembers(text string, block sfcBlock, start int) []models.Symbol {
+	script := block.content
+	start = skipSpace(block.masked, start)
+	if start >= len(script) || !strings.ContainsRune("{[", rune(block.masked[start])) {
+		return nil
+	}
+
+	end := matchBrace(block.masked, start)
+	if end < 0 {
+		return nil
+	}
+
+	// 成员按逗号、分号和换行分隔，TypeScript 类型中泛型尖括号内的逗号不拆分
+	var members []models.Symbol
+	for _, segment := range splitTopLevel(block.masked, start+1, end, ",;\n", true) {
+		raw := script[segment[0]:segment[1]]
+		member := strings.TrimSpace(raw)
+		if member == "" || strings.HasPrefix(member, "//") || strings.HasPrefix(member, "/*") {
+			continue
+		}
+		purpose := ""
+		if idx := strings.Index(member, " //"); idx > 0 {
+			purpose = strings.TrimSpace(member[idx+3:])
+			member = strings.TrimSpace(member[:idx])
+		}
+
+		// 类型声明形式的事件签名只保留事件名
+		if match := emitSignatureRegex.FindStringSubmatch(member); match != nil {
+			member = match[1]
+		}
+		member = strings.Trim(member, `'"`)
+
+		memberStart := segment[0] + len(raw) - len(strings.TrimLeft(raw, " \t\r\n"))
+		memberEnd := segment[0] + len(strings.TrimRight(raw, " \t\r\n"))
+		members = append(members, models.Symbol{
+			Prototype: strings.Join(strings.Fields(member), " "),
+			Purpose:   purpose,
+			Range:     []int{lineOfOffset(text, block.contentStart+memberStart), lineOfOffset(text, block.contentStart+memberEnd)},
+		})
+	}
+
+	return members
+}
+
+// extractSvelteProps 提取 Svelte 组件属性（export let 以及 Svelte 5 的 $props()）
+func extractSvelteProps(text string, block sfcBlock) []models.Symbol {
+	var props []models.Symbol
+	for _, loc := range svelteExportLetRegex.FindAllStringIndex(block.content, -1) {
+		declaration := strings.TrimSpace(block.content[loc[0]:loc[1]])
+		line := lineOfOffset(text, block.contentStart+loc[0]+strings.Index(block.content[loc[0]:loc[1]], "export"))
+		props = append(props, models.Symbol{
+			Prototype: strings.TrimSuffix(declaration, ";"),
+			Range:     []int{line, line},
+		})
+	}
+
+	// Svelte 5：let { a, b = 1 }: Props = $props()，从解构模式的实际位置提取
+	for _, loc := range svelteRunePropsRegex.FindAllStringIndex(block.masked, -1) {
+		open := loc[1] - 1
+		end := matchBrace(block.masked, open)
+		if end > open && sveltePropsAssignRegex.MatchString(block.masked[end+1:]) {
+			props = append(props, extractObjectMembers(text, block, open)...)
+		}
+	}
+
+	return props
+}
+
+// extractSvelteEmits 提取 Svelte 组件通过 dispatch 派发的事件
+func extractSvelteEmits(text string, block sfcBlock) []models.Symbol {
+	var emits []models.Symbol
+	seen := make(map[string]bool)
+	for _, loc := range svelteDispatchRegex.FindAllStringSubmatchIndex(block.content, -1) {
+		name := block.content[loc[2]:loc[3]]
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		line := lineOfOffset(text, block.contentStart+loc[0])
+		emits = append(emits, models.Symbol{
+			Prototype: name,
+			Range:     []int{line, line},
+		})
+	}
+	return emits
+}
+
+// extractSFCExports 提取脚本中导出的函数和常量
+func extractSFCExports(text string, block sfcBlock) []models.Symbol {
+	var exports []models.Symbol
+	for _, loc := range sfcExportFunctionRegex.FindAllStringIndex(block.content, -1) {
+		declaration := strings.TrimSpace(block.content[loc[0]:loc[1]])
+		line := lineOfOffset(text, block.contentStart+loc[0]+strings.Index(block.content[loc[0]:loc[1]], "export"))
+		exports = append(exports, models.Symbol{
+			Prototype: strings.Join(strings.Fields(declaration), " "),
+			Range:     []int{line, line},
+		})
+	}
+	return exports
+}
+
+// shiftSymbolRanges 将符号（包括方法和子符号）的行号整体偏移
+func shiftSymbolRanges(symbols []models.Symbol, offset int) []models.Symbol {
+	for i := range symbols {
+		if len(symbols[i].Range) == 2 {
+			symbols[i].Range = []int{symbols[i].Range[0] + offset, symbols[i].Range[1] + offset}
+		}
+		symbols[i].Methods = shiftSymbolRanges(symbols[i].Methods, offset)
+		symbols[i].Children = shiftSymbolRanges(symbols[i].Children, offset)
+	}
+	return symbols
+}
+
+// spanRange 计算一组符号覆盖的行号范围
+func spanRange(symbols []models.Symbol) []int {
+	result := []int{symbols[0].Range[0], symbols[0].Range[1]}
+	for _, symbol := range symbols[1:] {
+		result = mergeRange(result, symbol.Range)
+	}
+	return result
+}
+
+// lineOfOffset 计算字节偏移所在的行号（从1开始）
+func lineOfOffset(text string, offset int) int {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	return strings.Count(text[:offset], "\n") + 1
+}
@@ -0,0 +1,185 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// ShellExtractor Shell脚本（bash/sh/zsh）提取器
+type ShellExtractor struct {
+	BaseExtractor
+}
+
+// NewShellExtractor 创建Shell脚本提取器
+func NewShellExtractor() *ShellExtractor {
+	return &ShellExtractor{}
+}
+
+// GetQueries Shell脚本的符号由 ExtractSymbols 遍历语法树提取，不使用查询规则
+func (s *ShellExtractor) GetQueries() []string {
+	return nil
+}
+
+// ExtractPrototype 提取函数签名或完整的导出/引用语句
+func (s *ShellExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	if node.Type() == "function_definition" {
+		return s.extractFunctionPrototype(node, content, s.IsFunctionBodyNode)
+	}
+
+	return s.extractFullNode(node, content)
+}
+
+// ExtractMethods Shell脚本没有类，返回空
+func (s *ShellExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	return []models.Symbol{}
+}
+
+// IsClassNode Shell脚本没有类节点
+func (s *ShellExtractor) IsClassNode(nodeType string) bool {
+	return false
+}
+
+// IsFunctionBodyNode 检查是否是函数体节点
+func (s *ShellExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "compound_statement" || nodeType == "subshell"
+}
+
+// IsInsideClass Shell脚本没有类，总是返回false
+func (s *ShellExtractor) IsInsideClass(node *sitter.Node) bool {
+	return false
+}
+
+// ExtractComments 提取节点上方连续的 # 注释
+func (s *ShellExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	startRow := int(node.StartPoint().Row)
+	lines := strings.Split(string(content), "\n")
+
+	var commentLines []string
+	for i := startRow - 1; i >= 0; i-- {
+		if i >= len(lines) {
+			continue
+		}
+
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			if len(commentLines) > 0 {
+				break
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(line, "#") || strings.HasPrefix(line, "#!") {
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimLeft(line, "#"))
+		if comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// ExtractSymbols 提取顶层的函数定义、导出变量以及 source/. 引用的文件
+func (s *ShellExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child == nil {
+			continue
+		}
+
+		switch child.Type() {
+		case "function_definition":
+		case "declaration_command":
+			if !s.isExportDeclaration(child, content) {
+				continue
+			}
+		case "command":
+			if !s.isSourceCommand(child, content) {
+				continue
+			}
+		default:
+			continue
+		}
+
+		symbols = append(symbols, models.Symbol{
+			Prototype: s.ExtractPrototype(child, content),
+			Purpose:   s.ExtractComments(child, content),
+			Range:     []int{int(child.StartPoint().Row) + 1, int(child.EndPoint().Row) + 1},
+		})
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取 shebang 之后的首个注释块作为文件用途
+func (s *ShellExtractor) ExtractFilePurpose(content []byte) string {
+	lines := strings.Split(string(content), "\n")
+
+	var commentLines []string
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if i == 0 && strings.HasPrefix(trimmed, "#!") {
+			continue
+		}
+
+		if trimmed == "" {
+			if len(commentLines) > 0 {
+				break
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+		// 跳过编辑器模式行和 shellcheck 指令
+		if comment == "" || strings.HasPrefix(comment, "shellcheck ") || strings.Contains(comment, "vim:") {
+			continue
+		}
+		commentLines = append(commentLines, comment)
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// isExportDeclaration 检查声明语句是否导出变量（export 或 declare -x）
+func (s *ShellExtractor) isExportDeclaration(node *sitter.Node, content []byte) bool {
+	fields := strings.Fields(node.Content(content))
+	if len(fields) == 0 {
+		return false
+	}
+
+	switch fields[0] {
+	case "export":
+		return true
+	case "declare", "typeset":
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "-") {
+				break
+			}
+			if strings.Contains(field, "x") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSourceCommand 检查命令是否为 source 或 . 引用其他脚本
+func (s *ShellExtractor) isSourceCommand(node *sitter.Node, content []byte) bool {
+	name := node.ChildByFieldName("name")
+	if name == nil {
+		return false
+	}
+
+	commandName := name.Content(content)
+	return commandName == "source" || commandName == "."
+}
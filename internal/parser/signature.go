@@ -0,0 +1,110 @@
+package parser
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// compactText 合并文本中的连续空白，用于类型和默认值等签名片段
+func compactText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// nodeText 返回节点合并空白后的文本，节点为空时返回空字符串
+func nodeText(node *sitter.Node, content []byte) string {
+	if node == nil {
+		return ""
+	}
+	return compactText(node.Content(content))
+}
+
+// fieldText 返回节点指定字段子节点合并空白后的文本
+func fieldText(node *sitter.Node, field string, content []byte) string {
+	return nodeText(node.ChildByFieldName(field), content)
+}
+
+// fieldNodes 返回节点中属于指定字段的全部子节点（如 Go 的 a, b int 中的多个名称）
+func fieldNodes(node *sitter.Node, field string) []*sitter.Node {
+	var nodes []*sitter.Node
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.FieldNameForChild(i) == field {
+			nodes = append(nodes, node.Child(i))
+		}
+	}
+	return nodes
+}
+
+// splitParamList 按顶层逗号拆分已屏蔽文本 [start, end) 区间内的参数列表，返回原文中去掉空白的各段，忽略空段
+func splitParamList(text, masked string, start, end int) []string {
+	return paramSegments(text, splitTopLevelCommas(masked, start, end))
+}
+
+// splitGenericParamList 与 splitParamList 相同，但泛型的尖括号也作为括号处理，用于 Dart、Objective-C 等
+// 参数类型中可以出现 <A, B> 的语言
+func splitGenericParamList(text, masked string, start, end int) []string {
+	return paramSegments(text, splitGenericCommas(masked, start, end))
+}
+
+// paramSegments 返回各区间在原文中去掉空白后的非空文本
+func paramSegments(text string, segments [][2]int) []string {
+	var params []string
+	for _, segment := range segments {
+		if param := strings.TrimSpace(text[segment[0]:segment[1]]); param != "" {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// splitDefault 按顶层的 = 把参数文本拆成声明和默认值两部分（忽略 ==、=>、<=、>=、!= 等运算符），
+// 没有默认值时第二部分为空
+func splitDefault(text string) (string, string) {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '(', '[', '{', '<':
+			depth++
+		case ')', ']', '}', '>':
+			if depth > 0 && !(text[i] == '>' && i > 0 && text[i-1] == '=') {
+				depth--
+			}
+		case '"', '\'':
+			// 默认值中的字符串字面量
+			for quote := text[i]; i+1 < len(text) && text[i+1] != quote; i++ {
+				if text[i+1] == '\\' {
+					i++
+				}
+			}
+			i++
+		case '=':
+			if depth > 0 || i+1 < len(text) && (text[i+1] == '=' || text[i+1] == '>') {
+				continue
+			}
+			if i > 0 && strings.IndexByte("=!<>:", text[i-1]) >= 0 {
+				continue
+			}
+			return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:])
+		}
+	}
+	return strings.TrimSpace(text), ""
+}
+
+// splitTypeList 按不在任何括号（包括泛型尖括号）内的逗号拆分类型参数等列表，返回去掉空白的非空各段
+func splitTypeList(text string) []string {
+	return paramSegments(text, splitGenericCommas(text, 0, len(text)))
+}
+
+// splitTypedName 把 Type name 形式的声明拆成名称和类型：最后一个标识符为名称，之前的部分为类型
+func splitTypedName(declaration string) (string, string) {
+	declaration = strings.TrimSpace(declaration)
+	end := len(declaration)
+	start := end
+	for start > 0 && isWordChar(declaration[start-1]) {
+		start--
+	}
+	if start == end {
+		return "", declaration
+	}
+	return declaration[start:end], strings.TrimSpace(declaration[:start])
+}
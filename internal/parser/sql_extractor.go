@@ -0,0 +1,370 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var (
+	// sqlMigrationMarkerRegex 匹配 goose / sql-migrate 的迁移分段注释（如 -- +goose Up）
+	sqlMigrationMarkerRegex = regexp.MustCompile(`^--\s*\+(goose|migrate)\s+(Up|Down)\b`)
+
+	// sqlCreateHeadRegex 匹配语法树无法识别的 CREATE 语句头部（如存储过程）
+	sqlCreateHeadRegex = regexp.MustCompile(`(?is)^CREATE\s+(OR\s+REPLACE\s+)?(PROCEDURE|FUNCTION|TRIGGER|VIEW|TABLE|INDEX|TYPE)\s+[^\s(;]+(\s*\([^)]*\))?`)
+
+	// sqlStringSyntax SQL 的字符串和带引号的标识符，查找行尾注释前屏蔽其中的 --
+	sqlStringSyntax = textSyntax{quotes: `'"`}
+)
+
+// SQLExtractor SQL语言提取器
+type SQLExtractor struct {
+	BaseExtractor
+	queries []string
+}
+
+// NewSQLExtractor 创建SQL语言提取器
+func NewSQLExtractor() *SQLExtractor {
+	return &SQLExtractor{
+		queries: []string{
+			"(create_table) @symbol",
+			"(create_view) @symbol",
+			"(create_materialized_view) @symbol",
+			"(create_index) @symbol",
+			"(create_function) @symbol",
+			"(create_trigger) @symbol",
+			"(alter_table) @symbol",
+		},
+	}
+}
+
+// GetQueries 获取SQL语言的Tree-sitter查询规则
+func (s *SQLExtractor) GetQueries() []string {
+	return s.queries
+}
+
+// ExtractPrototype 提取语句原型（表/视图/函数不包含定义体）
+func (s *SQLExtractor) ExtractPrototype(node *sitter.Node, content []byte) string {
+	var bodyTypes []string
+	switch node.Type() {
+	case "create_table":
+		bodyTypes = []string{"column_definitions", "keyword_as", "create_query"}
+	case "create_view", "create_materialized_view":
+		bodyTypes = []string{"keyword_as", "create_query"}
+	case "create_function":
+		bodyTypes = []string{"function_body"}
+	}
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child == nil {
+			continue
+		}
+		for _, bodyType := range bodyTypes {
+			if child.Type() == bodyType {
+				return s.cleanText(string(content[node.StartByte():child.StartByte()]))
+			}
+		}
+	}
+
+	return strings.TrimSuffix(s.extractFullNode(node, content), ";")
+}
+
+// ExtractMethods SQL没有方法，返回空
+func (s *SQLExtractor) ExtractMethods(classNode *sitter.Node, content []byte) []models.Symbol {
+	return []models.Symbol{}
+}
+
+// IsClassNode 检查是否是表节点
+func (s *SQLExtractor) IsClassNode(nodeType string) bool {
+	return nodeType == "create_table"
+}
+
+// IsFunctionBodyNode 检查是否是函数体节点
+func (s *SQLExtractor) IsFunctionBodyNode(nodeType string) bool {
+	return nodeType == "function_body"
+}
+
+// IsInsideClass SQL语句不存在嵌套的类，总是返回false
+func (s *SQLExtractor) IsInsideClass(node *sitter.Node) bool {
+	return false
+}
+
+// ExtractComments 提取语句上方紧邻的连续 -- 注释
+func (s *SQLExtractor) ExtractComments(node *sitter.Node, content []byte) string {
+	startRow := int(node.StartPoint().Row)
+	lines := strings.Split(string(content), "\n")
+
+	var commentLines []string
+	for i := startRow - 1; i >= 0; i-- {
+		if i >= len(lines) {
+			continue
+		}
+
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			// 只取紧邻的注释，空行之前的注释（如文件头的说明）不属于该语句
+			break
+		}
+
+		// 迁移分段注释单独作为符号，不计入语句说明
+		if !strings.HasPrefix(line, "--") || sqlMigrationMarkerRegex.MatchString(line) {
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimPrefix(line, "--"))
+		if comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// ExtractSymbols 提取 DDL 语句与迁移分段，表的列作为子符号，迁移分段中的语句作为分段的子符号
+func (s *SQLExtractor) ExtractSymbols(root *sitter.Node, content []byte) []models.Symbol {
+	var symbols []models.Symbol
+	migrationIndex := -1
+
+	// 迁移分段之后的语句放入当前分段，避免与分段范围重叠的同级符号
+	appendSymbol := func(symbol models.Symbol) {
+		if migrationIndex >= 0 {
+			symbols[migrationIndex].Children = append(symbols[migrationIndex].Children, symbol)
+			return
+		}
+		symbols = append(symbols, symbol)
+	}
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child == nil {
+			continue
+		}
+
+		switch child.Type() {
+		case "comment":
+			line := strings.TrimSpace(child.Content(content))
+			if !sqlMigrationMarkerRegex.MatchString(line) {
+				continue
+			}
+			// 上一个迁移分段在当前分段之前结束
+			if migrationIndex >= 0 {
+				symbols[migrationIndex].Range[1] = maxInt(symbols[migrationIndex].Range[0], int(child.StartPoint().Row))
+			}
+			migrationIndex = len(symbols)
+			symbols = append(symbols, models.Symbol{
+				Prototype: line,
+				Range:     []int{int(child.StartPoint().Row) + 1, strings.Count(strings.TrimRight(string(content), "\n"), "\n") + 1},
+			})
+
+		case "statement":
+			statement := child.NamedChild(0)
+			if statement == nil || !s.isSchemaStatement(statement.Type()) {
+				continue
+			}
+			appendSymbol(s.createStatementSymbol(child, statement, content))
+
+		case "ERROR":
+			if symbol, ok := s.createFallbackSymbol(child, content); ok {
+				appendSymbol(symbol)
+			}
+		}
+	}
+
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的 -- 注释块作为文件用途
+// （紧贴第一条语句的注释块属于该语句，不作为文件用途）
+func (s *SQLExtractor) ExtractFilePurpose(content []byte) string {
+	var commentLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if len(commentLines) > 0 {
+				break
+			}
+			continue
+		}
+
+		if sqlMigrationMarkerRegex.MatchString(trimmed) {
+			break
+		}
+		if !strings.HasPrefix(trimmed, "--") {
+			return ""
+		}
+
+		if comment := strings.TrimSpace(strings.TrimPrefix(trimmed, "--")); comment != "" {
+			commentLines = append(commentLines, comment)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// isSchemaStatement 检查语句是否为需要输出的结构定义或迁移语句
+func (s *SQLExtractor) isSchemaStatement(nodeType string) bool {
+	return strings.HasPrefix(nodeType, "create_") ||
+		strings.HasPrefix(nodeType, "alter_") ||
+		strings.HasPrefix(nodeType, "drop_") ||
+		nodeType == "rename_object"
+}
+
+// createStatementSymbol 创建语句符号，CREATE TABLE 的列作为子符号
+func (s *SQLExtractor) createStatementSymbol(statementNode, node *sitter.Node, content []byte) models.Symbol {
+	symbol := models.Symbol{
+		Prototype: s.ExtractPrototype(node, content),
+		Purpose:   s.ExtractComments(statementNode, content),
+		Range:     []int{int(statementNode.StartPoint().Row) + 1, int(statementNode.EndPoint().Row) + 1},
+	}
+
+	if s.IsClassNode(node.Type()) {
+		symbol.Children = s.extractColumns(node, content)
+	}
+	if node.Type() == "create_function" {
+		symbol.Params, symbol.Returns = s.functionSignature(node, content)
+	}
+
+	return symbol
+}
+
+// functionSignature 解析函数参数和 RETURNS 子句：IN/OUT/INOUT 写入参数类型，VARIADIC 标记为可变参数，
+// DEFAULT 或 = 之后为默认值
+func (s *SQLExtractor) functionSignature(node *sitter.Node, content []byte) ([]models.Param, []string) {
+	var params []models.Param
+	var returns []string
+	afterReturns := false
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch {
+		case child.Type() == "function_arguments":
+			for k := 0; k < int(child.NamedChildCount()); k++ {
+				if argument := child.NamedChild(k); argument.Type() == "function_argument" {
+					params = append(params, s.functionArgument(argument, content))
+				}
+			}
+		case child.Type() == "keyword_returns":
+			afterReturns = true
+		case afterReturns:
+			// 返回类型在函数体、LANGUAGE 等选项之前结束
+			if strings.HasPrefix(child.Type(), "function_") || child.Type() == "keyword_as" || child.Type() == "keyword_language" {
+				afterReturns = false
+				continue
+			}
+			if len(returns) == 0 {
+				returns = []string{""}
+			}
+			returns[0] = s.joinTypeText(returns[0], nodeText(child, content))
+		}
+	}
+	return params, returns
+}
+
+// functionArgument 解析单个函数参数
+func (s *SQLExtractor) functionArgument(node *sitter.Node, content []byte) models.Param {
+	var param models.Param
+	inDefault := false
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch {
+		case inDefault:
+			param.Default = strings.TrimSpace(param.Default + " " + nodeText(child, content))
+		case child.Type() == "keyword_default" || child.Type() == "=":
+			inDefault = true
+		case child.Type() == "keyword_variadic":
+			param.Variadic = true
+		case child.Type() == "keyword_in" || child.Type() == "keyword_out" || child.Type() == "keyword_inout":
+			param.Type = s.joinTypeText(param.Type, strings.ToUpper(child.Content(content)))
+		case child.Type() == "identifier" && param.Name == "":
+			param.Name = child.Content(content)
+		default:
+			param.Type = s.joinTypeText(param.Type, nodeText(child, content))
+		}
+	}
+	return param
+}
+
+// joinTypeText 拼接类型的组成部分，数组维度（[]）和括号内的列定义紧跟在前一部分之后
+func (s *SQLExtractor) joinTypeText(text, part string) string {
+	if text == "" || strings.HasPrefix(part, "[") || strings.HasPrefix(part, "(") {
+		return text + part
+	}
+	return text + " " + part
+}
+
+// extractColumns 提取表的列定义及其类型
+func (s *SQLExtractor) extractColumns(tableNode *sitter.Node, content []byte) []models.Symbol {
+	var columns []models.Symbol
+
+	for i := 0; i < int(tableNode.NamedChildCount()); i++ {
+		definitions := tableNode.NamedChild(i)
+		if definitions == nil || definitions.Type() != "column_definitions" {
+			continue
+		}
+
+		for j := 0; j < int(definitions.NamedChildCount()); j++ {
+			column := definitions.NamedChild(j)
+			if column == nil || column.Type() != "column_definition" {
+				continue
+			}
+
+			columns = append(columns, models.Symbol{
+				Prototype: s.extractFullNode(column, content),
+				Purpose:   s.extractTrailingComment(column, content),
+				Range:     []int{int(column.StartPoint().Row) + 1, int(column.EndPoint().Row) + 1},
+			})
+		}
+	}
+
+	return columns
+}
+
+// extractTrailingComment 提取列定义行尾的 -- 注释（忽略字符串中的 --，如 DEFAULT '--'）
+func (s *SQLExtractor) extractTrailingComment(node *sitter.Node, content []byte) string {
+	lines := strings.Split(string(content), "\n")
+	row := int(node.EndPoint().Row)
+	if row >= len(lines) {
+		return ""
+	}
+
+	if idx := strings.Index(maskSource(lines[row], sqlStringSyntax), "--"); idx >= 0 {
+		return strings.TrimSpace(lines[row][idx+2:])
+	}
+	return ""
+}
+
+// createFallbackSymbol 为语法树无法解析的 CREATE 语句（如方言相关的存储过程）生成符号
+func (s *SQLExtractor) createFallbackSymbol(node *sitter.Node, content []byte) (models.Symbol, bool) {
+	text := string(content[node.StartByte():])
+	head := sqlCreateHeadRegex.FindString(text)
+	if head == "" {
+		return models.Symbol{}, false
+	}
+
+	end := node.StartByte() + uint32(s.findStatementEnd(text))
+	return models.Symbol{
+		Prototype: s.cleanText(head),
+		Purpose:   s.ExtractComments(node, content),
+		Range:     []int{int(node.StartPoint().Row) + 1, strings.Count(string(content[:end]), "\n") + 1},
+	}, true
+}
+
+// findStatementEnd 查找语句结束的分号位置，跳过 $$ 包裹的函数体和字符串
+func (s *SQLExtractor) findStatementEnd(text string) int {
+	inDollarQuote := false
+	inString := false
+	for i := 0; i < len(text); i++ {
+		switch {
+		case !inString && strings.HasPrefix(text[i:], "$$"):
+			inDollarQuote = !inDollarQuote
+			i++
+		case !inDollarQuote && text[i] == '\'':
+			inString = !inString
+		case !inDollarQuote && !inString && text[i] == ';':
+			return i
+		}
+	}
+	return len(text)
+}
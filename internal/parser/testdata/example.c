@@ -7,6 +7,24 @@
 #include <stdlib.h>
 #include <string.h>
 
+/** 用户名最大长度 */
+#define MAX_NAME_LEN 64
+
+/* 检查用户指针是否有效 */
+#define USER_OK(u) ((u) != NULL && (u)->name != NULL)
+
+/// 已创建的用户总数
+extern int g_user_count;
+
+/**
+ * 用户状态
+ */
+enum user_status {
+    USER_ACTIVE,    /* 正常 */
+    USER_DISABLED,  /* 已禁用 */
+    USER_DELETED = 9
+};
+
 /**
  * 用户结构体
  * 表示系统中的用户实体
@@ -27,6 +45,14 @@ typedef struct {
     int capacity;
 } UserManager;
 
+/* 二维坐标，字段不应继承这条注释 */
+typedef struct { int x; int y; } Point;
+
+/* 用户变更时的回调，是函数指针变量而不是函数原型 */
+void (*on_user_changed)(User* user);
+
+void free_user(User* user);
+
 /**
  * 创建新的用户实例
  * 
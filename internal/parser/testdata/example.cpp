@@ -136,8 +136,35 @@ public:
         
         return std::make_pair(totalUsers, validUsers);
     }
+
+    /**
+     * 清空所有用户
+     */
+    void clear();
 };
 
+void UserManager::clear() {
+    users.clear();
+}
+
+/**
+ * 按条件过滤用户
+ *
+ * @param users 用户列表
+ * @param pred 过滤条件
+ * @return std::vector<const User*> 满足条件的用户
+ */
+template <typename Predicate>
+std::vector<const User*> filterUsers(const std::vector<std::unique_ptr<User>>& users, Predicate pred) {
+    std::vector<const User*> result;
+    for (const auto& user : users) {
+        if (pred(*user)) {
+            result.push_back(user.get());
+        }
+    }
+    return result;
+}
+
 /**
  * 用户工具类
  * 提供用户相关的工具方法
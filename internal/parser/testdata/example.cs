@@ -121,4 +121,57 @@ namespace UserManagement
                    user.Email.Contains("@");
         }
     }
+
+    /// <summary>
+    /// 用户角色
+    /// </summary>
+    public enum Role
+    {
+        /// <summary>管理员</summary>
+        Admin = 1,
+        /// <summary>普通成员</summary>
+        Member
+    }
+
+    /// <summary>
+    /// 用户摘要，只包含对外展示的字段
+    /// </summary>
+    /// <param name="Id">用户ID</param>
+    /// <param name="Name">用户名</param>
+    public record UserSummary(int Id, string Name);
+
+    /// <summary>
+    /// 用户变更回调
+    /// </summary>
+    public delegate void UserChangedHandler(User user);
+
+    /// <summary>
+    /// 用户 HTTP 接口
+    /// </summary>
+    [ApiController]
+    [Route("api/users")]
+    public partial class UsersController
+    {
+        private readonly UserManager _manager = new UserManager();
+
+        /// <summary>用户被添加时触发</summary>
+        public event UserChangedHandler UserAdded;
+
+        /// <summary>当前用户数量</summary>
+        public int Count { get; private set; }
+
+        /// <summary>
+        /// 按索引获取用户
+        /// </summary>
+        /// <param name="index">索引</param>
+        public User this[int index] => _manager.GetUsers()[index];
+
+        /// <summary>
+        /// 根据 <paramref name="id"/> 查找用户
+        /// </summary>
+        /// <param name="id">用户ID</param>
+        /// <returns>找到的用户，未找到时返回 <c>null</c></returns>
+        [HttpGet("{id}")]
+        public User Get(int id) => _manager.FindUserById(id);
+    }
 }
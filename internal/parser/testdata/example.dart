@@ -0,0 +1,89 @@
+// Counter app demo showing widgets.
+// ignore_for_file: public_member_api_docs
+
+import 'package:flutter/material.dart';
+
+/// Entry point.
+void main() => runApp(const MyApp());
+
+/// Callback for counters.
+typedef CounterCallback = void Function(int value);
+
+const String appTitle = 'Demo {';
+
+/// The root widget.
+class MyApp extends StatelessWidget {
+  const MyApp({super.key});
+
+  static final routes = {'/': (context) => const HomePage()};
+
+  @override
+  Widget build(BuildContext context) {
+    return MaterialApp(title: appTitle, home: const HomePage());
+  }
+}
+
+class HomePage extends StatefulWidget {
+  const HomePage({super.key});
+
+  @override
+  State<HomePage> createState() => _HomePageState();
+}
+
+class _HomePageState extends State<HomePage> {
+  int _count = 0;
+
+  /// Current count.
+  int get count => _count;
+
+  void _increment() {
+    setState(() {
+      if (_count < 10) _count++;
+    });
+  }
+}
+
+/// A point.
+class Point {
+  final double x, y;
+  Point(this.x, this.y) : assert(x >= 0);
+  Point.origin() : this(0, 0);
+  factory Point.fromJson(Map<String, dynamic> json) =>
+      Point(json['x'] as double, json['y'] as double);
+  bool operator ==(Object other) => other is Point && other.x == x;
+}
+
+mixin Logger on Object {
+  void log(String msg) {
+    print('[$runtimeType] $msg }');
+  }
+}
+
+extension StringX on String {
+  String shout() => toUpperCase();
+}
+
+/// Colors.
+enum Color {
+  /// Red color.
+  red('r'),
+  green('g');
+
+  const Color(this.code);
+  final String code;
+}
+
+enum Simple { a, b, c }
+
+Future<List<int>> fetchAll(
+  String url, {
+  int retries = 3,
+}) async {
+  return [];
+}
+
+/// Groups [items] by the key returned from [keyOf].
+Map<K, List<V>> groupBy<K, V>(List<V> items, K Function(V) keyOf, {Map<K, List<V>>? into, int limit = 1 << 4}) {
+  final result = into ?? <K, List<V>>{};
+  return result;
+}
@@ -0,0 +1,37 @@
+%%% -*- mode: erlang -*-
+%%% @doc User cache server.
+
+-module(user_cache).
+-behaviour(gen_server).
+
+-export([start_link/0, get/1, decode/1]).
+-export([init/1, handle_call/3]).
+
+-record(state, {users = #{} :: map(), "x.y" = 1}).
+
+-define(TIMEOUT, 5000).
+
+%% @doc Starts the cache.
+-spec start_link() -> {ok, pid()}.
+start_link() ->
+    gen_server:start_link({local, ?MODULE}, ?MODULE, [], []).
+
+%% Looks up a user.
+get(Id) ->
+    gen_server:call(?MODULE, {get, Id}, ?TIMEOUT).
+
+init([]) -> {ok, #state{}}.
+
+handle_call({get, Id}, _From, State = #state{users = U}) when is_integer(Id) ->
+    case maps:find(Id, U) of
+        {ok, V} -> {reply, V, State};
+        error -> {reply, undefined, State}
+    end;
+handle_call(_Msg, _From, State) ->
+    X = 1.5, Y = State#state.users,
+    {reply, {X, Y, "a. b"}, State}.
+
+%% Decodes a length-prefixed record; the binary pattern is a single argument.
+-spec decode(binary()) -> {binary(), binary()}.
+decode(<<Len:16, Body:Len/binary, Rest/binary>>) ->
+    {Body, Rest}.
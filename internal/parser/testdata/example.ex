@@ -0,0 +1,49 @@
+defmodule MyApp.Accounts do
+  @moduledoc """
+  Accounts context.
+  """
+  @behaviour MyApp.Store
+
+  defmodule User do
+    @moduledoc "A user."
+    defstruct [:name, :email]
+  end
+
+  @doc """
+  Fetches a user.
+
+  More text.
+  """
+  @spec get(integer()) :: User.t()
+  def get(id), do: %User{name: id}
+
+  def list(opts \\ []) do
+    opts
+  end
+
+  def list(a, b) when is_list(a), do: a
+
+  @doc "Pages through the query."
+  def page(query, size \\ 20)
+  def page(query, size) when size > 0, do: {query, size}
+  def page(query, _size), do: query
+
+  defp helper(x), do: x
+
+  defmacro my_macro(expr) do
+    quote do: unquote(expr)
+  end
+
+  @impl true
+  def handle_call(:ping, _from, state), do: {:reply, :pong, state}
+  def handle_call(_msg, _from, state), do: {:noreply, state}
+end
+
+defprotocol Size do
+  @doc "Calculates the size"
+  def size(data)
+end
+
+defimpl Size, for: BitString do
+  def size(string), do: byte_size(string)
+end
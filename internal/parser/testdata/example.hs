@@ -0,0 +1,58 @@
+{-|
+Module      : Data.Shape
+Description : Geometric shapes and their areas
+-}
+{-# LANGUAGE GADTSyntax #-}
+module Data.Shape
+  ( Shape(..)
+  , Point
+  , area
+  , Container(..)
+  ) where
+
+import qualified Data.Map as Map
+
+-- | A point in the plane.
+type Point = (Double, Double)
+
+-- | Supported shapes.
+data Shape
+  = Circle Point Double -- ^ centre and radius
+  | Rect { topLeft :: Point -- ^ upper-left corner
+         , size    :: (Double, Double)
+         }
+  deriving (Show, Eq)
+
+newtype Name = Name String
+
+data Expr a where
+  IntE  :: Int -> Expr Int
+  BoolE :: Bool -> Expr Bool
+
+-- | Things that hold values.
+class Container f where
+  -- | An empty container.
+  empty :: f a
+  insert :: a -> f a -> f a
+  toList :: f a -> [a]
+  toList _ = []
+
+instance Container [] where
+  empty = []
+  insert = (:)
+
+-- | Area of a shape.
+area :: Shape -> Double
+area (Circle _ r) = pi * r * r
+area (Rect _ (w, h))
+  | w < 0 = 0
+  | otherwise = w * h
+
+(<+>) :: Shape -> Shape -> Double
+a <+> b = area a + area b
+
+helper x = x + 1
+
+main :: IO ()
+main = do
+  print (area (Circle (0, 0) 1))
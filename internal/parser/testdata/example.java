@@ -138,3 +138,56 @@ class UserUtils {
                user.getEmail().contains("@");
     }
 }
+
+/**
+ * 用户角色
+ */
+enum Role {
+    /** 管理员 */
+    ADMIN("admin"),
+    /** 普通成员 */
+    MEMBER("member");
+
+    private final String code;
+
+    Role(String code) {
+        this.code = code;
+    }
+
+    /**
+     * 获取角色编码
+     *
+     * @return String 角色编码
+     */
+    public String getCode() {
+        return this.code;
+    }
+}
+
+/**
+ * 用户摘要，只包含对外展示的字段
+ */
+record UserSummary(int id, String name) {
+    /**
+     * 从用户创建摘要
+     *
+     * @param user 用户对象
+     * @return UserSummary 用户摘要
+     */
+    static UserSummary of(User user) {
+        return new UserSummary(user.getId(), user.getName());
+    }
+
+    @Override
+    public String toString() {
+        return this.name + "#" + this.id;
+    }
+}
+
+/**
+ * 标记需要审计的操作
+ */
+@interface Audited {
+    /** 操作名称 */
+    String value() default "";
+}
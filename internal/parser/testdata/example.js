@@ -35,10 +35,30 @@ class User {
     }
 }
 
-// 工具函数
+/**
+ * 创建用户实例
+ * @param {number} id - 用户ID
+ * @param {string} name - 用户名
+ * @param {string} [email] - 邮箱地址
+ * @returns {User} 新创建的用户
+ */
 const createUser = (id, name, email) => {
     return new User(id, name, email);
 };
 
+// 用户数据的序列化工具
+const serializer = {
+    toJSON(user) {
+        return JSON.stringify({ id: user.id, name: user.name });
+    },
+    fromJSON: (text) => {
+        const data = JSON.parse(text);
+        return new User(data.id, data.name, data.email);
+    },
+};
+
 // 导出模块
 module.exports = { UserManager, User, createUser };
+
+// 默认的用户管理器实例
+module.exports.defaultManager = new UserManager();
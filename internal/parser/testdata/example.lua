@@ -0,0 +1,68 @@
+-- 用户管理模块
+-- 提供用户创建、查询和管理功能
+
+--- 用户管理器
+---@class UserManager
+local UserManager = {}
+UserManager.__index = UserManager
+
+--- 创建用户管理器
+---@return UserManager
+function UserManager.new()
+    local self = setmetatable({}, UserManager)
+    self.users = {}
+    return self
+end
+
+--- 添加用户到管理器
+---@param user table 用户对象
+---@return number 用户总数
+function UserManager:add_user(user)
+    table.insert(self.users, user)
+    return #self.users
+end
+
+--- 根据ID查找用户
+---@param id number 用户ID
+---@return table|nil
+function UserManager:find_user_by_id(id)
+    for _, user in ipairs(self.users) do
+        if user.id == id then
+            return user
+        end
+    end
+    return nil
+end
+
+--- 按条件筛选用户并格式化
+---@param filter fun(user: table): boolean 筛选条件
+---@param fmt? string 输出格式
+---@param ... any 格式化参数
+---@return string[] 格式化后的用户
+---@return integer # 匹配的数量
+function UserManager:format_users(filter, fmt, ...)
+    local result = {}
+    for _, user in ipairs(self.users) do
+        if filter(user) then
+            table.insert(result, string.format(fmt or "%s", user.name, ...))
+        end
+    end
+    return result, #result
+end
+
+-- 校验邮箱格式
+local function validate_email(email)
+    return string.match(email, "^[%w._-]+@[%w.-]+%.%a+$") ~= nil
+end
+
+-- 默认配置
+local defaults = {
+    max_users = 100,
+    on_error = function(err)
+        print(err)
+    end,
+}
+
+UserManager.validate_email = validate_email
+
+return UserManager
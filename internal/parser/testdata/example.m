@@ -0,0 +1,87 @@
+//
+//  ProfileViewController.m
+//  LegacyApp
+//
+//  Created by Jane Doe on 3/4/15.
+//  Copyright (c) 2015 Example Inc. All rights reserved.
+//
+
+#import "ProfileViewController.h"
+#import <UIKit/UIKit.h>
+
+NS_ASSUME_NONNULL_BEGIN
+
+@class User;
+@protocol ProfileDelegate;
+
+/// Profile loading state.
+typedef NS_ENUM(NSInteger, ProfileState) {
+    ProfileStateIdle,
+    ProfileStateLoading, ///< fetching
+    ProfileStateLoaded
+};
+
+/// Receives profile events.
+@protocol ProfileDelegate <NSObject>
+@required
+- (void)profileDidLoad:(User *)user;
+@optional
+- (void)profileDidFail:(NSError *)error withRetry:(BOOL)retry;
+@end
+
+/**
+ * Shows a user's profile.
+ */
+@interface ProfileViewController : UIViewController <UITableViewDataSource> {
+    @private
+    NSInteger _retryCount;
+    BOOL _loading;
+}
+
+@property (nonatomic, weak, nullable) id<ProfileDelegate> delegate;
+@property (nonatomic, strong) User *user;
+
++ (instancetype)controllerWithUser:(User *)user;
+- (void)reloadAnimated:(BOOL)animated
+            completion:(void (^)(BOOL finished))completion;
+@end
+
+@interface ProfileViewController (Analytics)
+- (void)trackView;
+@end
+
+@implementation ProfileViewController
+
+#pragma mark - Lifecycle
+
++ (instancetype)controllerWithUser:(User *)user {
+    ProfileViewController *vc = [[self alloc] init];
+    vc.user = user;
+    return vc;
+}
+
+// Reloads the profile from the server.
+- (void)reloadAnimated:(BOOL)animated completion:(void (^)(BOOL))completion
+{
+    if (completion) { completion(YES); }
+}
+
+@end
+
+static NSString *FormatName(NSString *first, NSString *last) {
+    return [NSString stringWithFormat:@"%@ %@", first, last];
+}
+
+/// Joins the names in a dictionary keyed by role.
+NSString *JoinNames(NSDictionary<NSString *, NSString *> *names, NSString *separator) {
+    return [[names allValues] componentsJoinedByString:separator];
+}
+
+/// Profile fetch options.
+typedef NS_OPTIONS(NSUInteger, ProfileOptions) {
+    ProfileOptionsNone = 0,
+    ProfileOptionsCache = 1 << 0,
+    ProfileOptionsRefresh = 1 << 1
+};
+
+NS_ASSUME_NONNULL_END
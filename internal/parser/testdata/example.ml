@@ -0,0 +1,29 @@
+(** Simple stack implementation. *)
+
+open Printf
+
+type 'a t = { mutable items : 'a list; mutable size : int }
+
+type color = Red | Green | Blue of int
+
+exception Empty
+
+module type PRINTABLE = sig
+  type t
+  val to_string : t -> string
+end
+
+module Make (P : PRINTABLE) = struct
+  let print x = print_endline (P.to_string x)
+end
+
+let create () = { items = []; size = 0 }
+
+let push s x =
+  s.items <- x :: s.items;
+  s.size <- s.size + 1
+
+let rec length = function [] -> 0 | _ :: t -> 1 + length t
+and count s = length s.items
+
+let () = printf "ready\n"
@@ -0,0 +1,18 @@
+(** Mutable stacks. *)
+
+type 'a t
+(** The type of stacks containing elements of type ['a]. *)
+
+type color = Red | Green | Blue of int
+
+exception Empty
+(** Raised when popping an empty stack. *)
+
+val create : unit -> 'a t
+(** Return a new, empty stack. *)
+
+val push : 'a t -> 'a -> unit
+(** [push s x] adds [x] to the top of [s]. *)
+
+(** Number of elements in a list. *)
+val length : 'a list -> int
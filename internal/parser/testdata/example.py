@@ -4,6 +4,16 @@
 提供用户创建、查询和管理功能
 """
 
+from dataclasses import dataclass, field
+from typing import Optional, TypeAlias
+
+__all__ = ["UserManager", "User", "Address", "create_user"]
+
+# 单个管理器允许的最大用户数
+MAX_USERS = 1000
+
+UserId: TypeAlias = int
+
 class UserManager:
     """用户管理器类"""
     
@@ -45,6 +55,25 @@ class UserManager:
                 return user
         return None
 
+@dataclass
+class Address:
+    """用户地址"""
+
+    city: str
+    street: str = ""  # 街道，可为空
+    tags: list[str] = field(default_factory=list)
+
+    class Meta:
+        """序列化配置"""
+
+        ordering = ["city"]
+
+    @property
+    def display(self) -> str:
+        """格式化后的地址"""
+        return f"{self.city} {self.street}".strip()
+
+
 class User:
     """用户类"""
     
@@ -2,14 +2,33 @@
 //! 提供用户创建、查询和管理功能
 
 use std::collections::HashMap;
+use std::fmt;
+
+/// 单个管理器允许的最大用户数
+pub const MAX_USERS: usize = 1000;
+
+/// 用户角色
+#[derive(Debug, Clone, PartialEq)]
+pub enum Role {
+    /// 管理员
+    Admin,
+    /// 普通成员，附带所属团队
+    Member(String),
+    /// 访客，附带过期时间戳
+    Guest { expires_at: u64 },
+}
 
 /// 用户结构体
 /// 表示系统中的用户实体
 #[derive(Debug, Clone)]
 pub struct User {
+    /// 用户ID
     pub id: u32,
+    /// 用户名
     pub name: String,
+    /// 邮箱地址
     pub email: String,
+    pub(crate) role: Role,
 }
 
 impl User {
@@ -25,7 +44,7 @@ impl User {
     /// 
     /// 返回新的用户实例
     pub fn new(id: u32, name: String, email: String) -> Self {
-        User { id, name, email }
+        User { id, name, email, role: Role::Admin }
     }
     
     /// 获取用户信息
@@ -49,6 +68,19 @@ impl User {
     }
 }
 
+impl fmt::Display for User {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        write!(f, "{} <{}>", self.name, self.email)
+    }
+}
+
+/// 生成用户的显示名称
+macro_rules! display_name {
+    ($user:expr) => {
+        format!("{}#{}", $user.name, $user.id)
+    };
+}
+
 /// 用户管理器
 /// 负责管理用户集合
 pub struct UserManager {
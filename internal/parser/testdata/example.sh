@@ -0,0 +1,29 @@
+#!/usr/bin/env bash
+# 用户管理脚本
+# 提供用户创建、查询和清理功能
+
+set -euo pipefail
+
+source "$(dirname "$0")/common.sh"
+
+export USER_DB="${USER_DB:-/var/lib/users.db}"
+declare -x MAX_USERS=100
+
+# 添加用户到数据库
+add_user() {
+    local name="$1"
+    local email="$2"
+    echo "${name},${email}" >> "$USER_DB"
+}
+
+# 根据名称查找用户
+function find_user {
+    grep "^$1," "$USER_DB" || true
+}
+
+# 清理临时文件
+function cleanup() {
+    rm -f "${USER_DB}.tmp"
+}
+
+trap cleanup EXIT
@@ -0,0 +1,38 @@
+-- 用户管理模块的数据库结构
+-- 包含用户表、视图、索引和触发器
+
+-- 用户表
+CREATE TABLE users (
+    id SERIAL PRIMARY KEY,          -- 用户ID
+    name VARCHAR(100) NOT NULL,     -- 用户名
+    email VARCHAR(255) NOT NULL,    -- 邮箱
+    nickname VARCHAR(50) DEFAULT '--' NOT NULL,
+    status VARCHAR(20) DEFAULT '-- none --',  -- 账号状态
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP
+);
+
+-- 按邮箱唯一索引
+CREATE UNIQUE INDEX idx_users_email ON users (email);
+
+-- 最近注册的用户
+CREATE VIEW recent_users AS
+SELECT id, name, email FROM users WHERE created_at > now() - INTERVAL '7 days';
+
+-- 更新 updated_at 字段
+CREATE OR REPLACE FUNCTION touch_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- 用户更新时自动刷新时间戳
+CREATE TRIGGER users_touch BEFORE UPDATE ON users
+FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+-- +goose Up
+ALTER TABLE users ADD COLUMN last_login TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN last_login;
@@ -0,0 +1,54 @@
+# 示例基础设施：日志存储桶与网络
+
+terraform {
+  required_version = ">= 1.5"
+
+  backend "s3" {
+    bucket = "example-terraform-state"
+    key    = "infra/terraform.tfstate"
+  }
+}
+
+provider "aws" {
+  region = var.region
+}
+
+variable "region" {
+  type        = string
+  default     = "us-east-1"
+  description = "部署区域"
+}
+
+# 附加到所有资源的标签
+variable "tags" {
+  type = map(string)
+  default = {
+    env = "prod"
+  }
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "example-logs"
+  tags   = local.common_tags
+}
+
+data "aws_iam_policy_document" "logs_read" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.logs.arn}/*"]
+  }
+}
+
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+}
+
+output "bucket_arn" {
+  value       = aws_s3_bucket.logs.arn
+  description = "日志存储桶的 ARN"
+}
+
+locals {
+  common_tags = merge(var.tags, { managed_by = "terraform" })
+}
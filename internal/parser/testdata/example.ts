@@ -0,0 +1,57 @@
+// Package-level helpers for parsing user input.
+
+/** Maximum accepted input length. */
+export const MAX_LENGTH = 256;
+
+/** Normalizes raw input; the angle-bracket cast must not hide later declarations. */
+export function normalize(raw: unknown): string {
+  const text = <string>raw;
+  return text.trim().slice(0, MAX_LENGTH);
+}
+
+/** Parsed user record. */
+export interface User {
+  id: number;
+  name: string;
+}
+
+/** Parses a user from JSON text. */
+export function parseUser(json: string): User {
+  const value = <User>JSON.parse(json);
+  return { id: value.id, name: normalize(value.name) };
+}
+
+/** Caches parsed users by id. */
+export class UserCache<K extends number = number> {
+  private readonly users = new Map<K, User>();
+
+  get(id: K): User | undefined {
+    return this.users.get(id);
+  }
+}
+
+/** Options accepted by the request helpers. */
+export interface RequestOptions extends Partial<User> {
+  // Base URL prepended to relative paths.
+  baseURL: string;
+  /** Request timeout in milliseconds. */
+  timeout?: number;
+  headers: Record<string, string>; // sent with every request
+  retry(attempt: number, error: Error): boolean;
+  onProgress?(loaded: number, total: number): void;
+  transformRequest?: Array<(data: unknown, headers: Record<string, string>) => unknown>;
+  transformResponse?: Array<(data: unknown) => unknown>;
+  validateStatus?: (status: number) => boolean;
+}
+
+/** Result of a request, tagged by outcome. */
+export type Result<T> =
+  | { ok: true; value: T } // success
+  | { ok: false; error: Error };
+
+/** Loose key-value map. */
+type Dict = {
+  [key: string]: unknown;
+  // Number of stored entries.
+  size: number;
+};
@@ -0,0 +1,48 @@
+import React, { forwardRef, memo, useCallback, useEffect, useState } from "react";
+
+export interface UserCardProps {
+  user: User;
+  onSelect?: (id: number) => void;
+}
+
+/**
+ * 加载用户资料
+ * @param {number} id - 用户ID
+ * @returns {User | undefined} 加载完成前为 undefined
+ */
+export function useUserProfile(id: number) {
+  const [user, setUser] = useState<User>();
+  useEffect(() => {
+    fetchUser(id).then(setUser);
+  }, [id]);
+  return user;
+}
+
+// 用户卡片，点击时回调 onSelect
+export const UserCard: React.FC<UserCardProps> = ({ user, onSelect }) => {
+  const handleClick = useCallback(() => onSelect?.(user.id), [user, onSelect]);
+  return <div onClick={handleClick}>{user.name}</div>;
+};
+
+// 带 ref 的搜索输入框
+export const SearchInput = forwardRef<HTMLInputElement, { placeholder?: string }>((props, ref) => (
+  <input ref={ref} placeholder={props.placeholder} />
+));
+
+// 用户列表
+export default memo(function UserList({ users }: { users: User[] }) {
+  return (
+    <>
+      {users.map((user) => (
+        <UserCard key={user.id} user={user} />
+      ))}
+    </>
+  );
+});
+
+// 旧版的类组件
+export class LegacyProfile extends React.Component<UserCardProps> {
+  render() {
+    return <UserCard {...this.props} />;
+  }
+}
@@ -0,0 +1,63 @@
+//! Geometry helpers.
+//! Second line.
+
+const std = @import("std");
+
+/// A 2D point.
+pub const Point = struct {
+    /// X coordinate.
+    x: f32 = 0,
+    y: f32 = 0,
+    origin: Inner = .{ .a = 1 },
+
+    pub const Inner = extern struct {
+        a: i32,
+    };
+
+    /// Creates a point.
+    pub fn init(x: f32, y: f32) Point {
+        return .{ .x = x, .y = y };
+    }
+
+    fn lenSq(self: Point) f32 {
+        const s = "}{";
+        return self.x * self.x + self.y * self.y;
+    }
+};
+
+pub const Color = enum(u8) {
+    red = 1,
+    green,
+    blue,
+
+    pub fn isRed(self: Color) bool {
+        return self == .red;
+    }
+};
+
+const Shape = union(enum) {
+    circle: f32,
+    square: f32,
+};
+
+pub const ParseError = error{ InvalidChar, Overflow };
+
+pub fn parse(buf: []const u8) error{Oops}!u32 {
+    _ = buf;
+    const msg =
+        \\ multi { line
+    ;
+    _ = msg;
+    return 0;
+}
+
+extern "c" fn write(fd: c_int, buf: [*]const u8, n: usize) isize;
+
+test "point init" {
+    const p = Point.init(1, 2);
+    try std.testing.expect(p.x == 1);
+}
+
+test parse {
+    _ = try parse("1");
+}
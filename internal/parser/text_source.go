@@ -0,0 +1,218 @@
+package parser
+
+import (
+	"strings"
+)
+
+// textSyntax 描述文本提取器需要屏蔽的注释和字符串语法
+type textSyntax struct {
+	lineComments  []string    // 行注释前缀，如 //
+	blockComments [][2]string // 块注释的开始和结束标记，如 /* */
+	quotes        string      // 字符串引号字符
+	tripleQuotes  bool        // 是否支持 ''' 和 """ 多行字符串
+}
+
+// maskSource 将注释和字符串内容替换为空格（保留换行和引号），
+// 使括号匹配和声明识别不受其中内容影响，同时保持偏移和行号不变
+func maskSource(text string, syntax textSyntax) string {
+	out := []byte(text)
+	blank := func(from, to int) {
+		for k := from; k < to && k < len(out); k++ {
+			if out[k] != '\n' {
+				out[k] = ' '
+			}
+		}
+	}
+
+	for i := 0; i < len(text); {
+		if end, ok := matchComment(text, i, syntax); ok {
+			blank(i, end)
+			i = end
+			continue
+		}
+
+		quote := text[i]
+		if strings.IndexByte(syntax.quotes, quote) < 0 {
+			i++
+			continue
+		}
+
+		// 多行字符串
+		if delimiter := strings.Repeat(string(quote), 3); syntax.tripleQuotes && strings.HasPrefix(text[i:], delimiter) {
+			end := len(text)
+			if idx := strings.Index(text[i+3:], delimiter); idx >= 0 {
+				end = i + 3 + idx
+			}
+			blank(i+3, end)
+			i = minInt(end+3, len(text))
+			continue
+		}
+
+		// 单行字符串
+		j := i + 1
+		for j < len(text) && text[j] != quote && text[j] != '\n' {
+			if text[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		blank(i+1, j)
+		i = j + 1
+	}
+
+	return string(out)
+}
+
+// matchComment 检查 i 处是否为注释开始，返回注释结束位置
+func matchComment(text string, i int, syntax textSyntax) (int, bool) {
+	for _, prefix := range syntax.lineComments {
+		if strings.HasPrefix(text[i:], prefix) {
+			if end := strings.IndexByte(text[i:], '\n'); end >= 0 {
+				return i + end, true
+			}
+			return len(text), true
+		}
+	}
+
+	for _, pair := range syntax.blockComments {
+		if strings.HasPrefix(text[i:], pair[0]) {
+			if end := strings.Index(text[i+len(pair[0]):], pair[1]); end >= 0 {
+				return i + len(pair[0]) + end + len(pair[1]), true
+			}
+			return len(text), true
+		}
+	}
+
+	return i, false
+}
+
+// matchBrace 在已屏蔽注释和字符串的文本中查找与 start 处 (、[ 或 { 匹配的结束位置，找不到时返回 -1
+func matchBrace(masked string, start int) int {
+	return matchBracket(masked, start, false)
+}
+
+// matchAngle 查找与 start 处 < 匹配的 >，其中嵌套的泛型尖括号和其他括号一并匹配，找不到时返回 -1
+func matchAngle(masked string, start int) int {
+	return matchBracket(masked, start, true)
+}
+
+// matchBracket 在已屏蔽注释和字符串的文本中查找与 start 处括号匹配的结束位置，找不到时返回 -1。
+// angles 为 true 时泛型的尖括号也作为括号（见 openBracket）
+func matchBracket(masked string, start int, angles bool) int {
+	var stack []byte
+	for i := start; i < len(masked); i++ {
+		if closer, ok := openBracket(masked, i, angles); ok || i == start && angles && masked[i] == '<' {
+			if !ok {
+				closer = '>'
+			}
+			stack = append(stack, closer)
+			continue
+		}
+		switch masked[i] {
+		case ')', ']', '}', '>':
+			if masked[i] == '>' && i > 0 && (masked[i-1] == '-' || masked[i-1] == '=') {
+				continue // -> 和 => 不是尖括号
+			}
+			if len(stack) > 0 && masked[i] == stack[len(stack)-1] {
+				stack = stack[:len(stack)-1]
+				if len(stack) == 0 {
+					return i
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// openBracket 检查 i 处是否为开括号并返回对应的结束括号。angles 为 true 时紧跟在标识符之后的 <
+// 也是开括号（如 Map<K, V>、List<List<int>>），a < b、1 << 4、a <= b 等运算符中的 < 不是
+func openBracket(masked string, i int, angles bool) (byte, bool) {
+	switch masked[i] {
+	case '(':
+		return ')', true
+	case '[':
+		return ']', true
+	case '{':
+		return '}', true
+	case '<':
+		if angles && i > 0 && isWordChar(masked[i-1]) && (i+1 >= len(masked) || masked[i+1] != '<' && masked[i+1] != '=') {
+			return '>', true
+		}
+	}
+	return 0, false
+}
+
+// splitTopLevel 按不在括号内的分隔符（separators 中的任一字符）拆分已屏蔽文本的 [start, end) 区间，
+// 返回各段的区间；angles 为 true 时泛型尖括号内的分隔符也不拆分
+func splitTopLevel(masked string, start, end int, separators string, angles bool) [][2]int {
+	var segments [][2]int
+	segmentStart := start
+	for i := start; i < end; i++ {
+		if _, ok := openBracket(masked, i, angles); ok {
+			if closeIdx := matchBracket(masked, i, angles); closeIdx > i && closeIdx < end {
+				i = closeIdx
+			}
+			continue
+		}
+		if strings.IndexByte(separators, masked[i]) >= 0 {
+			segments = append(segments, [2]int{segmentStart, i})
+			segmentStart = i + 1
+		}
+	}
+	return append(segments, [2]int{segmentStart, end})
+}
+
+// splitTopLevelCommas 按顶层逗号拆分已屏蔽文本的 [start, end) 区间
+func splitTopLevelCommas(masked string, start, end int) [][2]int {
+	return splitTopLevel(masked, start, end, ",", false)
+}
+
+// splitGenericCommas 按顶层逗号拆分已屏蔽文本的 [start, end) 区间，泛型尖括号内的逗号不拆分
+// （如 Map<String, dynamic> json）
+func splitGenericCommas(masked string, start, end int) [][2]int {
+	return splitTopLevel(masked, start, end, ",", true)
+}
+
+// skipSpace 返回 offset 之后第一个非空白字符的位置
+func skipSpace(text string, offset int) int {
+	for offset < len(text) && strings.IndexByte(" \t\r\n", text[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
+
+// docCommentAbove 提取指定行（从1开始）上方紧邻的文档注释，
+// 支持以 linePrefix 开头的连续行注释和 /** */ 块注释
+func docCommentAbove(lines []string, line int, linePrefix string) string {
+	var commentLines []string
+	i := line - 2
+	if i >= 0 && i < len(lines) && strings.HasSuffix(strings.TrimSpace(lines[i]), "*/") {
+		// /** */ 块注释
+		for ; i >= 0; i-- {
+			trimmed := strings.TrimSpace(lines[i])
+			start := strings.HasPrefix(trimmed, "/**")
+			trimmed = strings.TrimPrefix(trimmed, "/**")
+			trimmed = strings.TrimSuffix(trimmed, "*/")
+			trimmed = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(trimmed), "*"))
+			if trimmed != "" {
+				commentLines = append([]string{trimmed}, commentLines...)
+			}
+			if start {
+				return strings.Join(commentLines, " ")
+			}
+		}
+		return ""
+	}
+
+	for ; i >= 0 && i < len(lines); i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, linePrefix) {
+			break
+		}
+		if comment := strings.TrimSpace(strings.TrimPrefix(trimmed, linePrefix)); comment != "" {
+			commentLines = append([]string{comment}, commentLines...)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
@@ -9,14 +9,27 @@ import (
 	"time"
 
 	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/bash"
 	"github.com/smacker/go-tree-sitter/c"
 	"github.com/smacker/go-tree-sitter/cpp"
 	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/dockerfile"
+	"github.com/smacker/go-tree-sitter/elixir"
 	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/hcl"
 	"github.com/smacker/go-tree-sitter/java"
 	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/lua"
+	markdown "github.com/smacker/go-tree-sitter/markdown/tree-sitter-markdown"
+	"github.com/smacker/go-tree-sitter/ocaml"
+	"github.com/smacker/go-tree-sitter/protobuf"
 	"github.com/smacker/go-tree-sitter/python"
 	"github.com/smacker/go-tree-sitter/rust"
+	"github.com/smacker/go-tree-sitter/sql"
+	"github.com/smacker/go-tree-sitter/toml"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
+	"github.com/smacker/go-tree-sitter/typescript/typescript"
+	"github.com/smacker/go-tree-sitter/yaml"
 
 	"github.com/cnwinds/code-outline/internal/config"
 	"github.com/cnwinds/code-outline/internal/models"
@@ -26,12 +39,27 @@ const (
 	langGo         = "go"
 	langJavaScript = "javascript"
 	langTypeScript = "typescript"
+	langTSX        = "tsx" // .tsx 文件和 lang="tsx" 脚本区块使用的语法，不是独立的配置语言
 	langPython     = "python"
 	langJava       = "java"
 	langCSharp     = "csharp"
 	langRust       = "rust"
 	langC          = "c"
 	langCpp        = "cpp"
+	langLua        = "lua"
+	langShell      = "shell"
+	langSQL        = "sql"
+	langProtobuf   = "protobuf"
+	langVue        = "vue"
+	langSvelte     = "svelte"
+	langHCL        = "hcl"
+	langYAML       = "yaml"
+	langJSON       = "json"
+	langTOML       = "toml"
+	langMarkdown   = "markdown"
+	langElixir     = "elixir"
+	langOCaml      = "ocaml"
+	langDockerfile = "dockerfile"
 )
 
 // TreeSitterParser Tree-sitter 解析器
@@ -66,7 +94,16 @@ func (p *TreeSitterParser) initParsers() {
 	jsParser := sitter.NewParser()
 	jsParser.SetLanguage(javascript.GetLanguage())
 	p.parsers["javascript"] = jsParser
-	p.parsers["typescript"] = jsParser // 暂时共用
+
+	// TypeScript（.ts 文件不能使用 TSX 语法，否则 <T>value 形式的类型断言会被当作 JSX 元素）
+	tsParser := sitter.NewParser()
+	tsParser.SetLanguage(typescript.GetLanguage())
+	p.parsers["typescript"] = tsParser
+
+	// TSX
+	tsxParser := sitter.NewParser()
+	tsxParser.SetLanguage(tsx.GetLanguage())
+	p.parsers["tsx"] = tsxParser
 
 	// Python
 	pyParser := sitter.NewParser()
@@ -97,6 +134,119 @@ func (p *TreeSitterParser) initParsers() {
 	cppParser := sitter.NewParser()
 	cppParser.SetLanguage(cpp.GetLanguage())
 	p.parsers["cpp"] = cppParser
+
+	// Lua
+	luaParser := sitter.NewParser()
+	luaParser.SetLanguage(lua.GetLanguage())
+	p.parsers["lua"] = luaParser
+
+	// Shell
+	shellParser := sitter.NewParser()
+	shellParser.SetLanguage(bash.GetLanguage())
+	p.parsers["shell"] = shellParser
+
+	// SQL
+	sqlParser := sitter.NewParser()
+	sqlParser.SetLanguage(sql.GetLanguage())
+	p.parsers["sql"] = sqlParser
+
+	// Protocol Buffers
+	protoParser := sitter.NewParser()
+	protoParser.SetLanguage(protobuf.GetLanguage())
+	p.parsers["protobuf"] = protoParser
+
+	// Terraform/HCL
+	hclParser := sitter.NewParser()
+	hclParser.SetLanguage(hcl.GetLanguage())
+	p.parsers["hcl"] = hclParser
+
+	// YAML
+	yamlParser := sitter.NewParser()
+	yamlParser.SetLanguage(yaml.GetLanguage())
+	p.parsers["yaml"] = yamlParser
+
+	// TOML
+	tomlParser := sitter.NewParser()
+	tomlParser.SetLanguage(toml.GetLanguage())
+	p.parsers["toml"] = tomlParser
+
+	// Markdown
+	markdownParser := sitter.NewParser()
+	markdownParser.SetLanguage(markdown.GetLanguage())
+	p.parsers["markdown"] = markdownParser
+
+	// Elixir
+	elixirParser := sitter.NewParser()
+	elixirParser.SetLanguage(elixir.GetLanguage())
+	p.parsers["elixir"] = elixirParser
+
+	// OCaml
+	ocamlParser := sitter.NewParser()
+	ocamlParser.SetLanguage(ocaml.GetLanguage())
+	p.parsers["ocaml"] = ocamlParser
+
+	// Dockerfile
+	dockerfileParser := sitter.NewParser()
+	dockerfileParser.SetLanguage(dockerfile.GetLanguage())
+	p.parsers["dockerfile"] = dockerfileParser
+}
+
+// getLanguage 根据语言名称获取 Tree-sitter 语言对象，不支持时返回 nil
+func getLanguage(langName string) *sitter.Language {
+	switch langName {
+	case langGo:
+		return golang.GetLanguage()
+	case langJavaScript:
+		return javascript.GetLanguage()
+	case langTypeScript:
+		return typescript.GetLanguage()
+	case langTSX:
+		return tsx.GetLanguage()
+	case langPython:
+		return python.GetLanguage()
+	case langJava:
+		return java.GetLanguage()
+	case langCSharp:
+		return csharp.GetLanguage()
+	case langRust:
+		return rust.GetLanguage()
+	case langC:
+		return c.GetLanguage()
+	case langCpp:
+		return cpp.GetLanguage()
+	case langLua:
+		return lua.GetLanguage()
+	case langShell:
+		return bash.GetLanguage()
+	case langSQL:
+		return sql.GetLanguage()
+	case langProtobuf:
+		return protobuf.GetLanguage()
+	case langHCL:
+		return hcl.GetLanguage()
+	case langYAML:
+		return yaml.GetLanguage()
+	case langTOML:
+		return toml.GetLanguage()
+	case langMarkdown:
+		return markdown.GetLanguage()
+	case langElixir:
+		return elixir.GetLanguage()
+	case langOCaml:
+		return ocaml.GetLanguage()
+	case langDockerfile:
+		return dockerfile.GetLanguage()
+	default:
+		return nil
+	}
+}
+
+// grammarName 返回解析时使用的语法名称：TypeScript 的 .tsx 文件使用 TSX 语法，其他文件与语言名称相同
+func grammarName(langName, ext string) string {
+	if langName == langTypeScript && strings.EqualFold(ext, ".tsx") {
+		return langTSX
+	}
+	return langName
 }
 
 // ParseFile 解析单个文件
@@ -107,34 +257,68 @@ func (p *TreeSitterParser) ParseFile(filePath string) (*models.FileInfo, error)
 		return nil, fmt.Errorf("读取文件失败: %w", err)
 	}
 
-	// 确定语言
-	ext := filepath.Ext(filePath)
-	langName, _, found := config.GetLanguageByExtension(p.languagesConfig, ext)
+	// 确定语言：文件名、模式行、扩展名和 shebang，.h 头文件结合内容区分 C、C++ 和 Objective-C
+	langName, found := config.DetectLanguage(p.languagesConfig, filePath, content)
 	if !found {
-		return nil, fmt.Errorf("不支持的文件类型: %s", ext)
+		return nil, fmt.Errorf("不支持的文件类型: %s", filepath.Ext(filePath))
 	}
 
-	// 为每次解析创建新的解析器实例（tree-sitter 不是线程安全的）
-	parser := sitter.NewParser()
-	var language *sitter.Language
+	// 单文件组件先拆分区块，脚本区块交给 JS/TS 提取器处理；配置文件只输出键的结构；
+	// OCaml 接口文件需要包装后解析
+	var symbols []models.Symbol
+	var purpose string
 	switch langName {
-	case langGo:
-		language = golang.GetLanguage()
-	case langJavaScript, langTypeScript:
-		language = javascript.GetLanguage()
-	case langPython:
-		language = python.GetLanguage()
-	case langJava:
-		language = java.GetLanguage()
-	case langCSharp:
-		language = csharp.GetLanguage()
-	case langRust:
-		language = rust.GetLanguage()
-	case langC:
-		language = c.GetLanguage()
-	case langCpp:
-		language = cpp.GetLanguage()
+	case langVue, langSvelte:
+		symbols, purpose, err = p.parseSFC(filePath, content, langName)
+	case langYAML, langJSON, langTOML:
+		symbols, purpose, err = p.parseConfigFile(filePath, content, langName)
+	case langOCaml:
+		symbols, purpose, err = p.parseOCaml(filePath, content)
 	default:
+		symbols, err = p.parseSymbols(filePath, content, grammarName(langName, filepath.Ext(filePath)))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取文件信息
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// 提取文件用途，提取器有自定义规则时优先使用
+	if purpose == "" {
+		purpose = p.extractFilePurpose(content)
+		var extractor interface{} = p.extractorFactory.GetExtractor(langName)
+		if textExtractor, ok := p.extractorFactory.GetTextExtractor(langName); ok {
+			extractor = textExtractor
+		}
+		if purposeExtractor, ok := extractor.(FilePurposeExtractor); ok {
+			purpose = purposeExtractor.ExtractFilePurpose(content)
+		}
+	}
+
+	return &models.FileInfo{
+		Purpose:      purpose,
+		Language:     langName,
+		Symbols:      symbols,
+		LastModified: fileInfo.ModTime().Format(time.RFC3339),
+		FileSize:     fileInfo.Size(),
+	}, nil
+}
+
+// parseSymbols 使用指定语言的 Tree-sitter 语法解析内容并提取符号
+func (p *TreeSitterParser) parseSymbols(filePath string, content []byte, langName string) ([]models.Symbol, error) {
+	// 没有 Tree-sitter 语法的语言直接基于文本提取
+	if textExtractor, ok := p.extractorFactory.GetTextExtractor(langName); ok {
+		return textExtractor.ExtractSymbols(content), nil
+	}
+
+	// 为每次解析创建新的解析器实例（tree-sitter 不是线程安全的）
+	parser := sitter.NewParser()
+	language := getLanguage(langName)
+	if language == nil {
 		return nil, fmt.Errorf("未找到 %s 语言的解析器", langName)
 	}
 	parser.SetLanguage(language)
@@ -168,22 +352,7 @@ func (p *TreeSitterParser) ParseFile(filePath string) (*models.FileInfo, error)
 		symbols = p.extractSymbols(rootNode, content, langName)
 	}()
 
-	if parseErr != nil {
-		return nil, parseErr
-	}
-
-	// 获取文件信息
-	fileInfo, err := os.Stat(filePath)
-	if err != nil {
-		return nil, err
-	}
-
-	return &models.FileInfo{
-		Purpose:      p.extractFilePurpose(content),
-		Symbols:      symbols,
-		LastModified: fileInfo.ModTime().Format(time.RFC3339),
-		FileSize:     fileInfo.Size(),
-	}, nil
+	return symbols, parseErr
 }
 
 // extractSymbols 从语法树提取符号
@@ -191,31 +360,19 @@ func (p *TreeSitterParser) extractSymbols(node *sitter.Node, content []byte, lan
 	var symbols []models.Symbol
 
 	// 获取语言对象
-	var language *sitter.Language
-	switch lang {
-	case langGo:
-		language = golang.GetLanguage()
-	case langJavaScript, langTypeScript:
-		language = javascript.GetLanguage()
-	case langPython:
-		language = python.GetLanguage()
-	case langJava:
-		language = java.GetLanguage()
-	case langCSharp:
-		language = csharp.GetLanguage()
-	case langRust:
-		language = rust.GetLanguage()
-	case langC:
-		language = c.GetLanguage()
-	case langCpp:
-		language = cpp.GetLanguage()
-	default:
+	language := getLanguage(lang)
+	if language == nil {
 		return symbols
 	}
 
 	// 获取语言提取器
 	extractor := p.extractorFactory.GetExtractor(lang)
 
+	// 需要自行遍历语法树的提取器直接返回其结果
+	if treeExtractor, ok := extractor.(TreeExtractor); ok {
+		return treeExtractor.ExtractSymbols(node, content)
+	}
+
 	// 使用提取器的查询规则
 	extractorQueries := extractor.GetQueries()
 
@@ -271,6 +428,13 @@ func (p *TreeSitterParser) nodeToSymbolWithExtractor(node *sitter.Node, content
 		Range:     []int{int(start.Row) + 1, int(end.Row) + 1},
 	}
 
+	if visibilityExtractor, ok := extractor.(VisibilityExtractor); ok {
+		symbol.Visibility, symbol.Modifiers = visibilityExtractor.ExtractVisibility(node, content)
+	}
+	if signatureExtractor, ok := extractor.(SignatureExtractor); ok {
+		symbol.TypeParams, symbol.Params, symbol.Returns = signatureExtractor.ExtractSignature(node, content)
+	}
+
 	// 如果是类节点，使用语言特定的提取器提取类内部的方法
 	if extractor.IsClassNode(nodeType) {
 		symbol.Methods = extractor.ExtractMethods(node, content)
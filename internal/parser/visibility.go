@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cnwinds/code-outline/internal/models"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// 符号可见性（models.Symbol.Visibility 的取值）
+const (
+	visibilityPublic    = models.VisibilityPublic
+	visibilityProtected = models.VisibilityProtected
+	visibilityPrivate   = models.VisibilityPrivate
+	visibilityInternal  = models.VisibilityInternal
+	visibilityPackage   = models.VisibilityPackage
+	visibilityExported  = models.VisibilityExported
+)
+
+// isCapitalized 检查名称是否以大写字母开头（Go 的导出规则）
+func isCapitalized(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
+// underscoreVisibility 按前导下划线约定判断可见性（Python、Dart）：_name 为私有，__name__ 形式的特殊名称为公开
+func underscoreVisibility(name string) string {
+	if strings.HasPrefix(name, "__") && strings.HasSuffix(name, "__") && len(name) > 4 {
+		return visibilityPublic
+	}
+	if strings.HasPrefix(name, "_") {
+		return visibilityPrivate
+	}
+	return visibilityPublic
+}
+
+// keywordModifiers 收集节点直接子节点中属于 keywords 的关键字（按出现顺序，去重）
+func keywordModifiers(node *sitter.Node, content []byte, keywords map[string]bool) []string {
+	var modifiers []string
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if word := node.Child(i).Content(content); keywords[word] {
+			modifiers = appendModifier(modifiers, word)
+		}
+	}
+	return modifiers
+}
+
+// appendModifier 在修饰符尚未出现时追加到列表
+func appendModifier(modifiers []string, word string) []string {
+	for _, existing := range modifiers {
+		if existing == word {
+			return modifiers
+		}
+	}
+	return append(modifiers, word)
+}
+
+// inheritVisibility 将成员的可见性设置为所在容器的可见性（如 trait 方法、枚举成员、接口成员）
+func inheritVisibility(members []models.Symbol, visibility string) {
+	for i := range members {
+		members[i].Visibility = visibility
+	}
+}
@@ -0,0 +1,267 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+var (
+	// zigSyntax Zig 的注释和字符串语法（\\ 多行字符串按行注释屏蔽）
+	zigSyntax = textSyntax{
+		lineComments: []string{"//", `\\`},
+		quotes:       `"'`,
+	}
+
+	// zigFunctionRegex 匹配函数声明
+	zigFunctionRegex = regexp.MustCompile(`^(pub\s+)?((export|extern(\s+"[^"]*")?|inline|noinline|threadlocal)\s+)*fn\s+\w+`)
+
+	// zigContainerRegex 匹配以结构体、枚举、联合、opaque 或错误集合为值的常量声明
+	zigContainerRegex = regexp.MustCompile(`^(pub\s+)?(const|var)\s+\w+\s*(:[^=]+)?=\s*((extern|packed)\s+)?(struct|enum|union|opaque|error)\b`)
+
+	// zigInlineTypeRegex 匹配以类型关键字结尾的头部，如返回类型中的 error{...}
+	zigInlineTypeRegex = regexp.MustCompile(`\b(error|struct|enum|union|opaque)\s*(\(.*\))?\s*$`)
+
+	// zigTestRegex 匹配 test 块
+	zigTestRegex = regexp.MustCompile(`^test\b`)
+
+	// zigFieldRegex 匹配容器字段（name: type）
+	zigFieldRegex = regexp.MustCompile(`^(comptime\s+)?@?\w+\s*:`)
+
+	// zigVariantRegex 匹配枚举成员或错误名称
+	zigVariantRegex = regexp.MustCompile(`^@?\w+(\s*=.*)?$`)
+)
+
+// zigModifierKeywords 函数声明中记录为修饰符的关键字
+var zigModifierKeywords = map[string]bool{
+	"export": true, "extern": true, "inline": true, "noinline": true, "threadlocal": true,
+}
+
+// ZigExtractor Zig语言提取器（基于文本，没有可用的 Tree-sitter 语法）
+type ZigExtractor struct {
+	BaseExtractor
+}
+
+// NewZigExtractor 创建Zig语言提取器
+func NewZigExtractor() *ZigExtractor {
+	return &ZigExtractor{}
+}
+
+// zigMember 容器中以 ;、, 或函数体 } 结束的一个成员
+type zigMember struct {
+	start     int    // 成员开始位置（跳过空白和注释）
+	end       int    // 成员结束位置（不含）
+	bodyStart int    // 函数体或容器体 { 的位置，没有时为 -1
+	head      string // 屏蔽后的成员文本
+}
+
+// ExtractSymbols 提取文件（即顶层结构体）中的函数、容器类型和 test 块
+func (z *ZigExtractor) ExtractSymbols(content []byte) []models.Symbol {
+	text := string(content)
+	masked := maskSource(text, zigSyntax)
+	lines := strings.Split(text, "\n")
+
+	symbols, _ := z.extractContainer(text, masked, lines, 0, len(masked))
+	return symbols
+}
+
+// ExtractFilePurpose 提取文件开头的 //! 顶层文档注释作为文件用途
+func (z *ZigExtractor) ExtractFilePurpose(content []byte) string {
+	var commentLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "//!") {
+			if trimmed == "" && len(commentLines) == 0 {
+				continue
+			}
+			break
+		}
+		if comment := strings.TrimSpace(strings.TrimPrefix(trimmed, "//!")); comment != "" {
+			commentLines = append(commentLines, comment)
+		}
+	}
+
+	return strings.Join(commentLines, " ")
+}
+
+// extractContainer 提取容器 [start, end) 区间内的声明，返回声明符号和字段/枚举成员
+func (z *ZigExtractor) extractContainer(text, masked string, lines []string, start, end int) (declarations []models.Symbol, fields []models.Symbol) {
+	for _, member := range z.splitMembers(masked, start, end) {
+		symbol := models.Symbol{
+			Prototype: z.prototype(text, member),
+			Purpose:   docCommentAbove(lines, lineOfOffset(masked, member.start), "///"),
+			Range:     []int{lineOfOffset(masked, member.start), lineOfOffset(masked, member.end-1)},
+		}
+
+		switch {
+		case zigFunctionRegex.MatchString(member.head):
+			symbol.Visibility = z.visibility(member.head)
+			symbol.Modifiers = z.extractModifiers(member.head)
+			symbol.TypeParams, symbol.Params, symbol.Returns = z.extractSignature(text, masked, member)
+			declarations = append(declarations, symbol)
+
+		case zigTestRegex.MatchString(member.head):
+			symbol.Kind = "test"
+			declarations = append(declarations, symbol)
+
+		case zigContainerRegex.MatchString(member.head):
+			symbol.Visibility = z.visibility(member.head)
+			if member.bodyStart >= 0 {
+				if closeIdx := matchBrace(masked, member.bodyStart); closeIdx > 0 {
+					nested, members := z.extractContainer(text, masked, lines, member.bodyStart+1, closeIdx)
+					symbol.Children = members
+					for _, declaration := range nested {
+						// 容器内的函数作为方法，嵌套容器和 test 作为子符号
+						if zigFunctionRegex.MatchString(declaration.Prototype) {
+							symbol.Methods = append(symbol.Methods, declaration)
+						} else {
+							symbol.Children = append(symbol.Children, declaration)
+						}
+					}
+				}
+			}
+			declarations = append(declarations, symbol)
+
+		case zigFieldRegex.MatchString(member.head), zigVariantRegex.MatchString(member.head):
+			if strings.HasPrefix(member.head, "const ") || strings.HasPrefix(member.head, "var ") ||
+				strings.HasPrefix(member.head, "pub ") || strings.HasPrefix(member.head, "usingnamespace ") {
+				continue
+			}
+			// 容器字段和枚举成员总是公开的
+			symbol.Visibility = visibilityPublic
+			fields = append(fields, symbol)
+		}
+	}
+
+	return declarations, fields
+}
+
+// visibility 带 pub 的声明为 public，否则只在所在文件内可见
+func (z *ZigExtractor) visibility(head string) string {
+	if strings.HasPrefix(head, "pub ") {
+		return visibilityPublic
+	}
+	return visibilityPrivate
+}
+
+// extractModifiers 提取 fn 之前的 export、extern、inline 等关键字
+func (z *ZigExtractor) extractModifiers(head string) []string {
+	var modifiers []string
+	for _, word := range strings.Fields(head) {
+		if word == "fn" {
+			break
+		}
+		if zigModifierKeywords[word] {
+			modifiers = appendModifier(modifiers, word)
+		}
+	}
+	return modifiers
+}
+
+// extractSignature 解析函数的参数和返回类型。comptime T: type 参数是 Zig 的泛型参数，同时记录为类型参数；
+// extern 函数的 ... 标记为可变参数，void 视为没有返回值
+func (z *ZigExtractor) extractSignature(text, masked string, member zigMember) ([]models.TypeParam, []models.Param, []string) {
+	headEnd := member.end
+	if member.bodyStart >= 0 {
+		headEnd = member.bodyStart
+	}
+	paren := strings.IndexByte(masked[member.start:headEnd], '(')
+	if paren < 0 {
+		return nil, nil, nil
+	}
+	paren += member.start
+	closeIdx := matchBrace(masked, paren)
+	if closeIdx < 0 || closeIdx > headEnd {
+		return nil, nil, nil
+	}
+
+	var typeParams []models.TypeParam
+	var params []models.Param
+	for _, segment := range splitParamList(text, masked, paren+1, closeIdx) {
+		if segment == "..." {
+			params = append(params, models.Param{Variadic: true})
+			continue
+		}
+		param := models.Param{Name: segment}
+		if colon := strings.IndexByte(segment, ':'); colon >= 0 {
+			param.Name, param.Type = strings.TrimSpace(segment[:colon]), compactText(segment[colon+1:])
+		}
+		// comptime、noalias 修饰写入类型
+		if fields := strings.Fields(param.Name); len(fields) == 2 {
+			param.Name, param.Type = fields[1], fields[0]+" "+param.Type
+			if fields[0] == "comptime" && param.Type == "comptime type" {
+				typeParams = append(typeParams, models.TypeParam{Name: param.Name})
+			}
+		}
+		params = append(params, param)
+	}
+
+	var returns []string
+	if returnType := strings.TrimRight(compactText(text[closeIdx+1:headEnd]), ";"); returnType != "" && returnType != "void" {
+		returns = []string{returnType}
+	}
+	return typeParams, params, returns
+}
+
+// splitMembers 将容器区间拆分为成员：声明以 ; 结束，字段以 , 结束，函数和 test 以函数体的 } 结束
+func (z *ZigExtractor) splitMembers(masked string, start, end int) []zigMember {
+	var members []zigMember
+	memberStart := start
+	bodyStart := -1
+
+	finish := func(memberEnd int) {
+		first := skipSpace(masked, memberStart)
+		if first < memberEnd {
+			head := strings.TrimSpace(masked[first:memberEnd])
+			head = strings.TrimSpace(strings.TrimRight(head, ";,"))
+			if head != "" {
+				members = append(members, zigMember{start: first, end: memberEnd, bodyStart: bodyStart, head: head})
+			}
+		}
+		memberStart = memberEnd
+		bodyStart = -1
+	}
+
+	for i := start; i < end; i++ {
+		switch masked[i] {
+		case '(', '[':
+			if closeIdx := matchBrace(masked, i); closeIdx > i && closeIdx < end {
+				i = closeIdx
+			}
+		case '{':
+			closeIdx := matchBrace(masked, i)
+			if closeIdx < 0 || closeIdx >= end {
+				closeIdx = end - 1
+			}
+			head := strings.TrimSpace(masked[skipSpace(masked, memberStart):i])
+			if zigFunctionRegex.MatchString(head) && zigInlineTypeRegex.MatchString(head) {
+				// 返回类型中的错误集合或匿名容器，不是函数体
+				i = closeIdx
+				continue
+			}
+			if bodyStart < 0 {
+				bodyStart = i
+			}
+			i = closeIdx
+			// 函数体和 test 块之后没有分号
+			if zigFunctionRegex.MatchString(head) || zigTestRegex.MatchString(head) || strings.HasPrefix(head, "comptime") {
+				finish(closeIdx + 1)
+			}
+		case ';', ',':
+			finish(i + 1)
+		}
+	}
+	finish(end)
+
+	return members
+}
+
+// prototype 提取成员原型：函数和 test 不含函数体，容器只保留到 {，字段和成员去除结尾的分隔符
+func (z *ZigExtractor) prototype(text string, member zigMember) string {
+	end := member.end
+	if member.bodyStart >= 0 && !zigFieldRegex.MatchString(member.head) {
+		end = member.bodyStart
+	}
+	return strings.TrimRight(z.cleanText(text[member.start:end]), ";,")
+}
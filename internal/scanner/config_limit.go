@@ -0,0 +1,61 @@
+package scanner
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+// maxProjectConfigSymbols 整个项目中配置/数据文件（YAML、JSON、TOML）最多输出的符号数量（包含子符号）。
+// 单个文件的上限由解析器控制，这里限制大量测试夹具、数据文件累积撑大结果
+const maxProjectConfigSymbols = 2000
+
+// configLanguages 按配置/数据文件处理的语言
+var configLanguages = map[string]bool{"yaml": true, "json": true, "toml": true}
+
+// LimitConfigSymbols 限制整个项目中配置/数据文件的符号总数：按目录层级由浅到深、再按路径排序依次计入，
+// 超出预算的文件只保留文件用途，不输出符号
+func LimitConfigSymbols(files map[string]models.FileInfo) {
+	var paths []string
+	for path, info := range files {
+		if configLanguages[info.Language] {
+			paths = append(paths, path)
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		di, dj := pathDepth(paths[i]), pathDepth(paths[j])
+		if di != dj {
+			return di < dj
+		}
+		return paths[i] < paths[j]
+	})
+
+	budget := maxProjectConfigSymbols
+	for _, path := range paths {
+		info := files[path]
+		count := countSymbols(info.Symbols)
+		if count <= budget {
+			budget -= count
+			continue
+		}
+		// 预算用完后，后面的文件即使符号更少也不再输出，保证结果只取决于路径顺序
+		budget = 0
+		info.Symbols = nil
+		files[path] = info
+	}
+}
+
+// pathDepth 返回相对路径的目录层级
+func pathDepth(path string) int {
+	return strings.Count(strings.ReplaceAll(path, "\\", "/"), "/")
+}
+
+// countSymbols 统计符号数量（包含方法和子符号）
+func countSymbols(symbols []models.Symbol) int {
+	count := len(symbols)
+	for _, symbol := range symbols {
+		count += countSymbols(symbol.Methods) + countSymbols(symbol.Children)
+	}
+	return count
+}
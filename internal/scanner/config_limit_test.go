@@ -0,0 +1,45 @@
+package scanner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+// configFile 创建包含 n 个顶层键的配置文件信息
+func configFile(language string, n int) models.FileInfo {
+	info := models.FileInfo{Language: language, Purpose: "fixture"}
+	for i := 0; i < n; i++ {
+		info.Symbols = append(info.Symbols, models.Symbol{Prototype: fmt.Sprintf("key%d", i), Range: []int{i + 1, i + 1}})
+	}
+	return info
+}
+
+func TestLimitConfigSymbols(t *testing.T) {
+	files := map[string]models.FileInfo{
+		"main.go":     {Language: "go", Symbols: make([]models.Symbol, maxProjectConfigSymbols+1)},
+		"config.yaml": configFile("yaml", 10),
+	}
+	// 大量测试夹具位于更深的目录，根目录的配置优先保留
+	for i := 0; i < 30; i++ {
+		files[fmt.Sprintf("testdata/fixtures/case%02d.json", i)] = configFile("json", 100)
+	}
+
+	LimitConfigSymbols(files)
+
+	assert.Len(t, files["main.go"].Symbols, maxProjectConfigSymbols+1, "源代码文件不受限制")
+	assert.Len(t, files["config.yaml"].Symbols, 10)
+
+	total := 0
+	for i := 0; i < 30; i++ {
+		info := files[fmt.Sprintf("testdata/fixtures/case%02d.json", i)]
+		assert.Equal(t, "fixture", info.Purpose, "超出预算的文件保留文件用途")
+		total += countSymbols(info.Symbols)
+	}
+	assert.Equal(t, maxProjectConfigSymbols-100, total)
+	assert.Len(t, files["testdata/fixtures/case18.json"].Symbols, 100)
+	assert.Empty(t, files["testdata/fixtures/case19.json"].Symbols)
+}
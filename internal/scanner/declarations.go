@@ -0,0 +1,234 @@
+package scanner
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+// kindDeclaration 没有实现体的函数声明（如 C/C++ 头文件中的原型）
+const kindDeclaration = "declaration"
+
+// languageCSharp 支持分部类型的语言
+const languageCSharp = "csharp"
+
+// LinkDeclarations 按限定名称把函数声明与其实现配对（如头文件中的 Widget::size 与 .cpp 中的类外定义）：
+// 声明的 definition 字段记录实现位置，实现的 declaration 字段记录声明位置。
+// 同名的多个声明和实现（重载）按参数类型和 const 限定配对，找不到签名相同的实现时不配对；
+// 已有的配对结果会先被清除。
+// 分部类型合并时复制的成员不参与配对
+func LinkDeclarations(files map[string]models.FileInfo) {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	declarations := make(map[string][]*models.Symbol)
+	definitions := make(map[string][]*models.Symbol)
+	locations := make(map[*models.Symbol]string)
+
+	var collect func(path string, symbols []models.Symbol)
+	collect = func(path string, symbols []models.Symbol) {
+		for i := range symbols {
+			symbol := &symbols[i]
+			if symbol.MergedFrom != "" {
+				continue
+			}
+			if symbol.QualifiedName != "" {
+				symbol.Declaration = ""
+				symbol.Definition = ""
+				if len(symbol.Range) > 0 {
+					locations[symbol] = fmt.Sprintf("%s:%d", path, symbol.Range[0])
+				}
+				if symbol.Kind == kindDeclaration {
+					declarations[symbol.QualifiedName] = append(declarations[symbol.QualifiedName], symbol)
+				} else {
+					definitions[symbol.QualifiedName] = append(definitions[symbol.QualifiedName], symbol)
+				}
+			}
+			collect(path, symbol.Methods)
+			collect(path, symbol.Children)
+		}
+	}
+	for _, path := range paths {
+		collect(path, files[path].Symbols)
+	}
+
+	for name, decls := range declarations {
+		defs := definitions[name]
+		if len(decls) == 1 && len(defs) == 1 {
+			// 没有重载时直接配对，参数类型的写法可以不同（如 typedef 别名）
+			decls[0].Definition = locations[defs[0]]
+			defs[0].Declaration = locations[decls[0]]
+			continue
+		}
+
+		used := make([]bool, len(defs))
+		for _, decl := range decls {
+			key := signatureKey(decl)
+			for k, def := range defs {
+				if !used[k] && signatureKey(def) == key {
+					used[k] = true
+					decl.Definition = locations[def]
+					def.Declaration = locations[decl]
+					break
+				}
+			}
+		}
+	}
+}
+
+// signatureKey 返回用于匹配重载的签名：去掉空白的参数类型列表，const 成员函数追加 const
+func signatureKey(symbol *models.Symbol) string {
+	types := make([]string, len(symbol.Params))
+	for i, param := range symbol.Params {
+		types[i] = strings.Join(strings.Fields(param.Type), "")
+		if param.Variadic {
+			types[i] += "..."
+		}
+	}
+	key := "(" + strings.Join(types, ",") + ")"
+	for _, modifier := range symbol.Modifiers {
+		if modifier == "const" {
+			key += " const"
+		}
+	}
+	return key
+}
+
+// MergePartialTypes 合并分布在多个文件中的 C# 分部类型（按限定名称匹配）：主要部分（优先选择手写的文件，
+// 其次按文件路径排序）追加其他部分的方法和子符号，被合并的成员的 mergedFrom 字段记录其实际位置；
+// 每个部分的 partial 字段记录其他部分的位置，没有访问修饰符的部分继承其他部分声明的可见性。
+// 重复执行时会先移除上一次合并的成员
+func MergePartialTypes(files map[string]models.FileInfo) {
+	paths := make([]string, 0, len(files))
+	for path, info := range files {
+		if info.Language == languageCSharp {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	type partialPart struct {
+		path   string
+		symbol *models.Symbol
+		nested bool // 是否为嵌套类型（外层是类型而不是命名空间）
+	}
+	parts := make(map[string][]partialPart)
+	var names []string
+
+	var collect func(path string, symbols []models.Symbol, nested bool)
+	collect = func(path string, symbols []models.Symbol, nested bool) {
+		for i := range symbols {
+			symbol := &symbols[i]
+			if symbol.QualifiedName != "" {
+				symbol.Methods = removeMergedMembers(symbol.Methods)
+				symbol.Children = removeMergedMembers(symbol.Children)
+				symbol.Partial = nil
+				if _, exists := parts[symbol.QualifiedName]; !exists {
+					names = append(names, symbol.QualifiedName)
+				}
+				parts[symbol.QualifiedName] = append(parts[symbol.QualifiedName], partialPart{path: path, symbol: symbol, nested: nested})
+			}
+			inner := !strings.HasPrefix(symbol.Prototype, "namespace ")
+			collect(path, symbol.Methods, inner)
+			collect(path, symbol.Children, inner)
+		}
+	}
+	for _, path := range paths {
+		collect(path, files[path].Symbols, false)
+	}
+
+	// 逆序处理，嵌套的分部类型先于外层类型合并，外层追加成员时复制的是合并后的结果
+	for n := len(names) - 1; n >= 0; n-- {
+		group := parts[names[n]]
+
+		// 没有访问修饰符的部分使用其他部分声明的可见性，都没有声明时顶层类型为 internal、嵌套类型为 private
+		visibility := ""
+		for _, part := range group {
+			if part.symbol.Visibility != "" {
+				visibility = part.symbol.Visibility
+				break
+			}
+		}
+		for _, part := range group {
+			switch {
+			case part.symbol.Visibility != "":
+			case visibility != "":
+				part.symbol.Visibility = visibility
+			case part.nested:
+				part.symbol.Visibility = models.VisibilityPrivate
+			default:
+				part.symbol.Visibility = models.VisibilityInternal
+			}
+		}
+
+		if len(group) < 2 {
+			continue
+		}
+		// 设计器或源生成器生成的文件不作为主要部分
+		sort.SliceStable(group, func(i, j int) bool {
+			return !isGeneratedSource(group[i].path) && isGeneratedSource(group[j].path)
+		})
+
+		locations := make([]string, len(group))
+		for i, part := range group {
+			locations[i] = fmt.Sprintf("%s:%d", part.path, part.symbol.Range[0])
+		}
+		for i, part := range group {
+			for k, location := range locations {
+				if k != i {
+					part.symbol.Partial = append(part.symbol.Partial, location)
+				}
+			}
+		}
+
+		primary := group[0].symbol
+		var methods, children []models.Symbol
+		for _, part := range group[1:] {
+			methods = append(methods, mergedMembers(part.path, part.symbol.Methods)...)
+			children = append(children, mergedMembers(part.path, part.symbol.Children)...)
+		}
+		primary.Methods = append(primary.Methods, methods...)
+		primary.Children = append(primary.Children, children...)
+	}
+}
+
+// isGeneratedSource 检查文件是否为设计器或源生成器生成的 C# 代码（如 Form1.Designer.cs、Foo.g.cs）
+func isGeneratedSource(path string) bool {
+	name := strings.ToLower(filepath.Base(path))
+	for _, suffix := range []string{".designer.cs", ".g.cs", ".g.i.cs", ".generated.cs"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergedMembers 复制另一个分部中的成员，并在 mergedFrom 字段中记录成员所在位置
+func mergedMembers(path string, members []models.Symbol) []models.Symbol {
+	merged := make([]models.Symbol, 0, len(members))
+	for _, member := range members {
+		member.MergedFrom = path
+		if len(member.Range) > 0 {
+			member.MergedFrom = fmt.Sprintf("%s:%d", path, member.Range[0])
+		}
+		merged = append(merged, member)
+	}
+	return merged
+}
+
+// removeMergedMembers 移除上一次合并时从其他分部追加的成员
+func removeMergedMembers(members []models.Symbol) []models.Symbol {
+	var kept []models.Symbol
+	for _, member := range members {
+		if member.MergedFrom == "" {
+			kept = append(kept, member)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,248 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnwinds/code-outline/internal/models"
+)
+
+func TestLinkDeclarations(t *testing.T) {
+	files := map[string]models.FileInfo{
+		"include/widget.h": {
+			Symbols: []models.Symbol{
+				{
+					Prototype: "class Widget",
+					Range:     []int{3, 10},
+					Methods: []models.Symbol{
+						{Prototype: "int size() const", Range: []int{5, 5}, Kind: kindDeclaration, QualifiedName: "ui::Widget::size"},
+						{Prototype: "void render()", Range: []int{6, 6}, Kind: kindDeclaration, QualifiedName: "ui::Widget::render"},
+						{Prototype: "void draw(int x)", Range: []int{7, 7}, Kind: kindDeclaration, QualifiedName: "ui::Widget::draw", Params: []models.Param{{Name: "x", Type: "int"}}},
+						{Prototype: "void draw(int x, int y)", Range: []int{8, 8}, Kind: kindDeclaration, QualifiedName: "ui::Widget::draw", Params: []models.Param{{Name: "x", Type: "int"}, {Name: "y", Type: "int"}}},
+					},
+				},
+			},
+		},
+		"src/widget.cpp": {
+			Symbols: []models.Symbol{
+				{Prototype: "int Widget::size() const", Range: []int{4, 6}, QualifiedName: "ui::Widget::size", Declaration: "stale.h:1"},
+				{Prototype: "void Widget::draw(int x)", Range: []int{8, 8}, QualifiedName: "ui::Widget::draw", Params: []models.Param{{Name: "x", Type: "int"}}},
+				{Prototype: "void Widget::draw(int x, int y)", Range: []int{9, 9}, QualifiedName: "ui::Widget::draw", Params: []models.Param{{Name: "x", Type: "int"}, {Name: "y", Type: "int"}}},
+			},
+		},
+	}
+
+	LinkDeclarations(files)
+
+	methods := files["include/widget.h"].Symbols[0].Methods
+	assert.Equal(t, "src/widget.cpp:4", methods[0].Definition)
+	assert.Empty(t, methods[1].Definition, "没有实现的声明不应被配对")
+	assert.Equal(t, "src/widget.cpp:8", methods[2].Definition)
+	assert.Equal(t, "src/widget.cpp:9", methods[3].Definition)
+
+	definitions := files["src/widget.cpp"].Symbols
+	assert.Equal(t, "include/widget.h:5", definitions[0].Declaration, "已有的配对结果应被重新计算")
+	assert.Equal(t, "include/widget.h:7", definitions[1].Declaration)
+	assert.Equal(t, "include/widget.h:8", definitions[2].Declaration)
+}
+
+func TestLinkDeclarationsOverloadOrder(t *testing.T) {
+	files := map[string]models.FileInfo{
+		"list.h": {
+			Symbols: []models.Symbol{
+				{Prototype: "int size() const", Range: []int{3, 3}, Kind: kindDeclaration, QualifiedName: "List::size", Modifiers: []string{"const"}},
+				{Prototype: "int size(int limit)", Range: []int{4, 4}, Kind: kindDeclaration, QualifiedName: "List::size", Params: []models.Param{{Name: "limit", Type: "int"}}},
+				{Prototype: "void push(const Item &item)", Range: []int{5, 5}, Kind: kindDeclaration, QualifiedName: "List::push", Params: []models.Param{{Name: "item", Type: "const Item &"}}},
+				{Prototype: "void push(Item &&item)", Range: []int{6, 6}, Kind: kindDeclaration, QualifiedName: "List::push", Params: []models.Param{{Name: "item", Type: "Item &&"}}},
+			},
+		},
+		"list.cpp": {
+			Symbols: []models.Symbol{
+				// 实现的顺序与头文件中不同
+				{Prototype: "int List::size(int n)", Range: []int{10, 12}, QualifiedName: "List::size", Params: []models.Param{{Name: "n", Type: "int"}}},
+				{Prototype: "int List::size() const", Range: []int{14, 16}, QualifiedName: "List::size", Modifiers: []string{"const"}},
+				{Prototype: "void List::push(long item)", Range: []int{18, 18}, QualifiedName: "List::push", Params: []models.Param{{Name: "item", Type: "long"}}},
+				{Prototype: "void List::push(const Item& item)", Range: []int{20, 20}, QualifiedName: "List::push", Params: []models.Param{{Name: "item", Type: "const Item&"}}},
+			},
+		},
+	}
+
+	LinkDeclarations(files)
+
+	declarations := files["list.h"].Symbols
+	assert.Equal(t, "list.cpp:14", declarations[0].Definition)
+	assert.Equal(t, "list.cpp:10", declarations[1].Definition)
+	assert.Equal(t, "list.cpp:20", declarations[2].Definition, "参数类型中的空白不影响匹配")
+	assert.Empty(t, declarations[3].Definition, "没有签名相同的实现时不应按位置配对")
+
+	definitions := files["list.cpp"].Symbols
+	assert.Equal(t, "list.h:4", definitions[0].Declaration)
+	assert.Equal(t, "list.h:3", definitions[1].Declaration)
+	assert.Empty(t, definitions[2].Declaration)
+	assert.Equal(t, "list.h:5", definitions[3].Declaration)
+}
+
+func TestMergePartialTypes(t *testing.T) {
+	files := map[string]models.FileInfo{
+		"Orders.cs": {
+			Language: languageCSharp,
+			Symbols: []models.Symbol{
+				{
+					Prototype:     "public partial class Orders",
+					Range:         []int{3, 20},
+					QualifiedName: "Shop.Orders",
+					Methods:       []models.Symbol{{Prototype: "public void Create()", Range: []int{5, 8}}},
+				},
+			},
+		},
+		"Orders.Generated.cs": {
+			Language: languageCSharp,
+			Symbols: []models.Symbol{
+				{
+					Prototype:     "partial class Orders",
+					Range:         []int{1, 9},
+					QualifiedName: "Shop.Orders",
+					Methods:       []models.Symbol{{Prototype: "public void Delete()", Range: []int{3, 3}}},
+					Children:      []models.Symbol{{Prototype: "private int _count", Range: []int{5, 5}}},
+				},
+			},
+		},
+	}
+
+	// 重复合并不应产生重复的成员
+	MergePartialTypes(files)
+	MergePartialTypes(files)
+
+	// 手写的 Orders.cs 优先于生成的 Orders.Generated.cs 作为主要部分
+	primary := files["Orders.cs"].Symbols[0]
+	assert.Equal(t, []string{"Orders.Generated.cs:1"}, primary.Partial)
+	assert.Len(t, primary.Methods, 2)
+	assert.Equal(t, "public void Delete()", primary.Methods[1].Prototype)
+	assert.Equal(t, "Orders.Generated.cs:3", primary.Methods[1].MergedFrom)
+	assert.Empty(t, primary.Methods[0].MergedFrom)
+	assert.Len(t, primary.Children, 1)
+	assert.Equal(t, "Orders.Generated.cs:5", primary.Children[0].MergedFrom)
+
+	other := files["Orders.Generated.cs"].Symbols[0]
+	assert.Equal(t, []string{"Orders.cs:3"}, other.Partial)
+	assert.Len(t, other.Methods, 1)
+	assert.Len(t, other.Children, 1)
+}
+
+func TestMergePartialTypesNestedWithLink(t *testing.T) {
+	files := map[string]models.FileInfo{
+		"A.cs": {
+			Language: languageCSharp,
+			Symbols: []models.Symbol{
+				{
+					Prototype:     "partial class Outer",
+					Range:         []int{1, 10},
+					QualifiedName: "App.Outer",
+					Children: []models.Symbol{
+						{
+							Prototype:     "partial class Inner",
+							Range:         []int{3, 6},
+							QualifiedName: "App.Outer.Inner",
+							Methods:       []models.Symbol{{Prototype: "void A()", Range: []int{4, 4}}},
+						},
+					},
+				},
+			},
+		},
+		"B.cs": {
+			Language: languageCSharp,
+			Symbols: []models.Symbol{
+				{
+					Prototype:     "partial class Outer",
+					Range:         []int{1, 8},
+					QualifiedName: "App.Outer",
+					Children: []models.Symbol{
+						{
+							Prototype:     "partial class Inner",
+							Range:         []int{2, 5},
+							QualifiedName: "App.Outer.Inner",
+							Methods:       []models.Symbol{{Prototype: "void B()", Range: []int{3, 3}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// 与增量更新一样重复执行配对和合并，复制的成员不应累积
+	for i := 0; i < 3; i++ {
+		LinkDeclarations(files)
+		MergePartialTypes(files)
+	}
+
+	outer := files["A.cs"].Symbols[0]
+	assert.Len(t, outer.Children, 2, "外层类型应包含自己的和合并来的嵌套类型各一个")
+	assert.Empty(t, outer.Children[0].MergedFrom)
+	assert.Len(t, outer.Children[0].Methods, 2)
+	assert.Equal(t, "B.cs:3", outer.Children[0].Methods[1].MergedFrom)
+	assert.Equal(t, "B.cs:2", outer.Children[1].MergedFrom)
+	assert.Empty(t, outer.Children[0].Definition)
+
+	other := files["B.cs"].Symbols[0]
+	assert.Len(t, other.Children, 1)
+	assert.Len(t, other.Children[0].Methods, 1)
+}
+
+func TestMergePartialTypesVisibility(t *testing.T) {
+	files := map[string]models.FileInfo{
+		"Form1.Designer.cs": {
+			Language: languageCSharp,
+			Symbols: []models.Symbol{
+				{
+					Prototype: "namespace App",
+					Range:     []int{1, 20},
+					Children: []models.Symbol{
+						{
+							Prototype:     "partial class Form1",
+							Range:         []int{3, 18},
+							QualifiedName: "App.Form1",
+							Methods:       []models.Symbol{{Prototype: "private void InitializeComponent()", Range: []int{8, 16}}},
+						},
+					},
+				},
+			},
+		},
+		"Form1.cs": {
+			Language: languageCSharp,
+			Symbols: []models.Symbol{
+				{
+					Prototype: "namespace App",
+					Range:     []int{1, 30},
+					Children: []models.Symbol{
+						{
+							Prototype:     "public partial class Form1",
+							Range:         []int{3, 28},
+							Visibility:    models.VisibilityPublic,
+							QualifiedName: "App.Form1",
+							Children: []models.Symbol{
+								{Prototype: "partial class Nested", Range: []int{5, 6}, QualifiedName: "App.Form1.Nested"},
+							},
+						},
+						{Prototype: "partial class Helper", Range: []int{29, 30}, QualifiedName: "App.Helper"},
+					},
+				},
+			},
+		},
+	}
+
+	MergePartialTypes(files)
+
+	// 没有访问修饰符的部分继承其他部分声明的 public，手写的 Form1.cs 作为主要部分
+	designer := files["Form1.Designer.cs"].Symbols[0].Children[0]
+	assert.Equal(t, models.VisibilityPublic, designer.Visibility)
+	form := files["Form1.cs"].Symbols[0].Children[0]
+	assert.Equal(t, []string{"Form1.Designer.cs:3"}, form.Partial)
+	assert.Len(t, form.Methods, 1)
+	assert.Equal(t, "Form1.Designer.cs:8", form.Methods[0].MergedFrom)
+	assert.Len(t, designer.Methods, 1)
+
+	// 所有部分都没有声明时，顶层类型为 internal，嵌套类型为 private
+	assert.Equal(t, models.VisibilityPrivate, form.Children[0].Visibility)
+	assert.Equal(t, models.VisibilityInternal, files["Form1.cs"].Symbols[0].Children[1].Visibility)
+}
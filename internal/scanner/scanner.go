@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/cnwinds/code-outline/internal/config"
 	"github.com/cnwinds/code-outline/internal/models"
 	"github.com/cnwinds/code-outline/internal/utils"
 )
@@ -16,10 +17,27 @@ type FileParser interface {
 	ParseFile(filePath string) (*models.FileInfo, error)
 }
 
+// defaultExcludedFiles 默认排除的文件名：code-outline 的默认输出文件和包管理器生成的锁文件
+var defaultExcludedFiles = map[string]bool{
+	"code-outline.json":   true,
+	"package-lock.json":   true,
+	"npm-shrinkwrap.json": true,
+	"pnpm-lock.yaml":      true,
+	"yarn.lock":           true,
+	"bun.lock":            true,
+	"deno.lock":           true,
+	"composer.lock":       true,
+	"Cargo.lock":          true,
+	"Gemfile.lock":        true,
+	"Pipfile.lock":        true,
+	"poetry.lock":         true,
+}
+
 // Scanner 文件扫描器
 type Scanner struct {
 	parser          FileParser
 	excludePatterns []string
+	outputFile      string // 输出文件的绝对路径，扫描时跳过
 }
 
 // NewScanner 创建新的扫描器实例
@@ -30,6 +48,32 @@ func NewScanner(parser FileParser, excludePatterns []string) *Scanner {
 	}
 }
 
+// SetOutputFile 设置输出文件路径，扫描时跳过该文件，避免把上一次生成的结果当作项目文件解析
+func (s *Scanner) SetOutputFile(path string) {
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+	s.outputFile = path
+}
+
+// DetectFileLanguage 检测文件语言：扩展名已配置时不读取文件内容；否则先按文件名规则检测，
+// 只有没有扩展名的文件才读取开头检查模式行和 shebang（不读取图片、压缩包等其他扩展名的文件）
+func DetectFileLanguage(languages models.LanguagesConfig, path string) (string, bool) {
+	ext := filepath.Ext(path)
+	if langName, _, known := config.GetLanguageByExtension(languages, ext); known {
+		return langName, true
+	}
+	if langName, found := config.DetectLanguage(languages, path, nil); found || ext != "" {
+		return langName, found
+	}
+	return config.DetectLanguage(languages, path, utils.ReadFileHead(path))
+}
+
+// IsExcludedFile 检查文件名是否为默认排除的输出文件或锁文件
+func IsExcludedFile(path string) bool {
+	return defaultExcludedFiles[filepath.Base(path)]
+}
+
 // ScanProject 扫描整个项目
 func (s *Scanner) ScanProject(projectPath string) (files map[string]models.FileInfo, techStack []string, err error) {
 	files = make(map[string]models.FileInfo)
@@ -69,6 +113,11 @@ func (s *Scanner) ScanProject(projectPath string) (files map[string]models.FileI
 	// 处理扫描错误
 	s.handleScanErrors(scanErrors, &errorsMu)
 
+	// 关联头文件中的声明与其实现，合并分部类型，限制配置/数据文件的符号总数
+	LinkDeclarations(files)
+	MergePartialTypes(files)
+	LimitConfigSymbols(files)
+
 	return files, techStack, nil
 }
 
@@ -81,6 +130,7 @@ func (s *Scanner) walkProjectFiles(
 	wg *sync.WaitGroup,
 	errorChan chan error,
 ) error {
+	languages := config.GetDefaultLanguagesConfig()
 	return filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -99,10 +149,17 @@ func (s *Scanner) walkProjectFiles(
 			return nil
 		}
 
-		// 检查文件扩展名
+		// 检查文件扩展名，无扩展名或扩展名不表示语言的文件（如 CMakeLists.txt）根据文件名、
+		// 模式行和 shebang 检测语言，并使用该语言的第一个扩展名统计技术栈
 		ext := filepath.Ext(path)
-		if ext == "" {
-			return nil
+		if _, _, known := config.GetLanguageByExtension(languages, ext); !known {
+			langName, found := DetectFileLanguage(languages, path)
+			if !found {
+				return nil
+			}
+			if extensions := languages[langName].Extensions; len(extensions) > 0 {
+				ext = extensions[0]
+			}
 		}
 
 		// 获取相对路径
@@ -168,6 +225,15 @@ func (s *Scanner) handleScanErrors(scanErrors []error, errorsMu *sync.Mutex) {
 
 // shouldExclude 检查路径是否应该被排除
 func (s *Scanner) shouldExclude(path string) bool {
+	if IsExcludedFile(path) {
+		return true
+	}
+	if s.outputFile != "" {
+		if absPath, err := filepath.Abs(path); err == nil && absPath == s.outputFile {
+			return true
+		}
+	}
+
 	// 默认排除模式
 	defaultExcludes := []string{
 		".git",
@@ -211,6 +277,8 @@ func (s *Scanner) getLanguageFromExtension(ext string) string {
 		".jsx":        "JavaScript",
 		".ts":         "TypeScript",
 		".tsx":        "TypeScript",
+		".vue":        "Vue",
+		".svelte":     "Svelte",
 		".py":         "Python",
 		".java":       "Java",
 		".c":          "C",
@@ -229,18 +297,23 @@ func (s *Scanner) getLanguageFromExtension(ext string) string {
 		".clj":        "Clojure",
 		".hs":         "Haskell",
 		".ml":         "OCaml",
+		".mli":        "OCaml",
 		".fs":         "F#",
 		".lua":        "Lua",
 		".r":          "R",
 		".m":          "Objective-C",
 		".mm":         "Objective-C++",
 		".dart":       "Dart",
+		".zig":        "Zig",
 		".elm":        "Elm",
 		".ex":         "Elixir",
 		".exs":        "Elixir",
 		".erl":        "Erlang",
 		".hrl":        "Erlang",
 		".sql":        "SQL",
+		".proto":      "Protocol Buffers",
+		".tf":         "Terraform",
+		".hcl":        "HCL",
 		".sh":         "Shell",
 		".bash":       "Bash",
 		".zsh":        "Zsh",
@@ -260,8 +333,11 @@ func (s *Scanner) getLanguageFromExtension(ext string) string {
 		".cfg":        "Config",
 		".conf":       "Config",
 		".md":         "Markdown",
+		".markdown":   "Markdown",
 		".tex":        "LaTeX",
 		".dockerfile": "Docker",
+		".mk":         "Make",
+		".cmake":      "CMake",
 		".Dockerfile": "Docker",
 	}
 
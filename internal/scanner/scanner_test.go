@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cnwinds/code-outline/internal/config"
 	"github.com/cnwinds/code-outline/internal/models"
 )
 
@@ -100,6 +101,85 @@ func TestScanProjectWithExcludePatterns(t *testing.T) {
 	}
 }
 
+func TestScanProjectExtensionlessScripts(t *testing.T) {
+	// 创建临时测试目录
+	tmpDir := t.TempDir()
+
+	// 带 shell shebang 的无扩展名脚本应被解析，其他无扩展名文件应被跳过
+	createTestFile(t, tmpDir, "deploy", shellTestCode)
+	createTestFile(t, tmpDir, "LICENSE", "MIT License")
+
+	parser := &mockParser{}
+	scanner := NewScanner(parser, nil)
+
+	files, techStack, err := scanner.ScanProject(tmpDir)
+
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Contains(t, techStack, "Shell")
+}
+
+func TestScanProjectDetectsExtensionlessLanguages(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// 无扩展名文件根据 shebang 和文件名检测语言
+	createTestFile(t, tmpDir, "manage", "#!/usr/bin/env python3\nprint('ok')\n")
+	createTestFile(t, tmpDir, "BUILD", "go_library(name = \"app\")\n")
+	createTestFile(t, tmpDir, "Makefile", "all: build\n")
+	createTestFile(t, tmpDir, "Dockerfile", "FROM alpine\n")
+	createTestFile(t, tmpDir, "CMakeLists.txt", "project(app)\n")
+	createTestFile(t, tmpDir, "NOTICE", "Copyright")
+	createTestFile(t, tmpDir, "notes.txt", "hello")
+
+	parser := &mockParser{}
+	scanner := NewScanner(parser, nil)
+
+	files, techStack, err := scanner.ScanProject(tmpDir)
+
+	require.NoError(t, err)
+	assert.Len(t, files, 5)
+	assert.Contains(t, files, "manage")
+	assert.Contains(t, files, "BUILD")
+	assert.Contains(t, files, "CMakeLists.txt")
+	assert.NotContains(t, files, "notes.txt")
+	assert.Contains(t, techStack, "Python")
+	assert.Contains(t, techStack, "Make")
+	assert.Contains(t, techStack, "Docker")
+	assert.Contains(t, techStack, "CMake")
+}
+
+func TestDetectFileLanguage(t *testing.T) {
+	tmpDir := t.TempDir()
+	languages := config.GetDefaultLanguagesConfig()
+
+	// 只有没有扩展名的文件才读取内容检查 shebang，其他未知扩展名的文件（如图片）不读取
+	createTestFile(t, tmpDir, "deploy", shellTestCode)
+	createTestFile(t, tmpDir, "logo.png", shellTestCode)
+	createTestFile(t, tmpDir, "Dockerfile.prod", "FROM alpine\n")
+
+	langName, found := DetectFileLanguage(languages, filepath.Join(tmpDir, "deploy"))
+	assert.True(t, found)
+	assert.Equal(t, "shell", langName)
+
+	_, found = DetectFileLanguage(languages, filepath.Join(tmpDir, "logo.png"))
+	assert.False(t, found)
+
+	langName, found = DetectFileLanguage(languages, filepath.Join(tmpDir, "Dockerfile.prod"))
+	assert.True(t, found)
+	assert.Equal(t, "dockerfile", langName)
+
+	// 已配置的扩展名优先于模式行，扫描器与解析器读取完整内容时的结果一致
+	createTestFile(t, tmpDir, "gen.py", "# vim: set ft=sh:\nprint('hi')\n")
+	path := filepath.Join(tmpDir, "gen.py")
+	langName, found = DetectFileLanguage(languages, path)
+	assert.True(t, found)
+	assert.Equal(t, "python", langName)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	fullLang, _ := config.DetectLanguage(languages, path, content)
+	assert.Equal(t, langName, fullLang)
+}
+
 func TestShouldExclude(t *testing.T) {
 	scanner := &Scanner{}
 
@@ -115,6 +195,10 @@ func TestShouldExclude(t *testing.T) {
 		{"src/main.go", false},
 		{".DS_Store", true},
 		{"temp.log", true},
+		{"code-outline.json", true},
+		{"web/package-lock.json", true},
+		{"pnpm-lock.yaml", true},
+		{"package.json", false},
 	}
 
 	for _, tc := range testCases {
@@ -125,6 +209,27 @@ func TestShouldExclude(t *testing.T) {
 	}
 }
 
+func TestScanProjectSkipsOutputAndLockFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// 上一次生成的输出文件（包括自定义名称）和锁文件不应作为项目文件解析
+	createTestFile(t, tmpDir, "main.go", goTestCode)
+	createTestFile(t, tmpDir, "package.json", "{\"name\": \"app\"}")
+	createTestFile(t, tmpDir, "package-lock.json", "{\"lockfileVersion\": 3}")
+	createTestFile(t, tmpDir, "code-outline.json", "{}")
+	createTestFile(t, tmpDir, "outline.json", "{}")
+
+	scanner := NewScanner(&mockParser{}, nil)
+	scanner.SetOutputFile(filepath.Join(tmpDir, "outline.json"))
+
+	files, _, err := scanner.ScanProject(tmpDir)
+
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Contains(t, files, "main.go")
+	assert.Contains(t, files, "package.json")
+}
+
 func TestShouldExcludeWithCustomPatterns(t *testing.T) {
 	excludePatterns := []string{"test", "temp"}
 	scanner := NewScanner(&mockParser{}, excludePatterns)
@@ -260,3 +365,11 @@ const jsTestCode = `function test() {
     console.log("test");
 }
 `
+
+const shellTestCode = `#!/usr/bin/env bash
+# 部署脚本
+
+deploy() {
+    echo "deploy"
+}
+`
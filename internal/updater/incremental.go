@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cnwinds/code-outline/internal/config"
 	"github.com/cnwinds/code-outline/internal/models"
 	"github.com/cnwinds/code-outline/internal/scanner"
 	"github.com/cnwinds/code-outline/internal/utils"
@@ -15,13 +16,16 @@ import (
 
 // IncrementalUpdater 增量更新器
 type IncrementalUpdater struct {
-	parser scanner.FileParser
+	parser      scanner.FileParser
+	languages   models.LanguagesConfig // 默认语言配置，用于判断文件是否支持
+	contextFile string                 // 上下文文件的绝对路径，检测变更时跳过
 }
 
 // NewIncrementalUpdater 创建新的增量更新器
 func NewIncrementalUpdater(p scanner.FileParser) *IncrementalUpdater {
 	return &IncrementalUpdater{
-		parser: p,
+		parser:    p,
+		languages: config.GetDefaultLanguagesConfig(),
 	}
 }
 
@@ -54,6 +58,10 @@ func (u *IncrementalUpdater) UpdateProject(
 	if err != nil {
 		return nil, nil, fmt.Errorf("加载现有上下文失败: %w", err)
 	}
+	u.contextFile = contextPath
+	if absPath, absErr := filepath.Abs(contextPath); absErr == nil {
+		u.contextFile = absPath
+	}
 
 	// 2. 扫描项目文件，检测变更
 	changes, err := u.detectFileChanges(existingContext, projectPath, excludePatterns, targetFiles, targetDirs)
@@ -141,8 +149,7 @@ func (u *IncrementalUpdater) detectFileChanges(
 		}
 
 		// 检查是否为支持的文件类型
-		ext := filepath.Ext(path)
-		if !u.isSupportedFile(ext) {
+		if !u.isSupportedFile(path) {
 			return nil
 		}
 
@@ -242,8 +249,7 @@ func (u *IncrementalUpdater) detectTargetChanges(
 		}
 
 		// 检查是否为支持的文件类型
-		ext := filepath.Ext(resolvedPath)
-		if !u.isSupportedFile(ext) {
+		if !u.isSupportedFile(resolvedPath) {
 			continue
 		}
 
@@ -314,8 +320,7 @@ func (u *IncrementalUpdater) detectTargetChanges(
 			}
 
 			// 检查是否为支持的文件类型
-			ext := filepath.Ext(path)
-			if !u.isSupportedFile(ext) {
+			if !u.isSupportedFile(path) {
 				return nil
 			}
 
@@ -402,6 +407,11 @@ func (u *IncrementalUpdater) applyChanges(context *models.ProjectContext, change
 
 	updatedContext.Files = updatedFiles
 
+	// 文件变更后重新关联声明与实现，合并分部类型，限制配置/数据文件的符号总数
+	scanner.LinkDeclarations(updatedFiles)
+	scanner.MergePartialTypes(updatedFiles)
+	scanner.LimitConfigSymbols(updatedFiles)
+
 	// 重新生成模块摘要
 	updatedContext.ModuleSummary = u.generateModuleSummary(updatedFiles)
 
@@ -434,8 +444,14 @@ func (u *IncrementalUpdater) generateModuleSummary(files map[string]models.FileI
 	return moduleSummary
 }
 
-// shouldExclude 检查路径是否应该被排除
+// shouldExclude 检查路径是否应该被排除（包括上下文文件本身和默认排除的锁文件）
 func (u *IncrementalUpdater) shouldExclude(path string, excludePatterns []string) bool {
+	if scanner.IsExcludedFile(path) {
+		return true
+	}
+	if absPath, err := filepath.Abs(path); err == nil && absPath == u.contextFile {
+		return true
+	}
 	path = filepath.ToSlash(path)
 	for _, pattern := range excludePatterns {
 		if matched, _ := filepath.Match(pattern, path); matched {
@@ -448,13 +464,8 @@ func (u *IncrementalUpdater) shouldExclude(path string, excludePatterns []string
 	return false
 }
 
-// isSupportedFile 检查是否为支持的文件类型
-func (u *IncrementalUpdater) isSupportedFile(ext string) bool {
-	supportedExts := []string{".go", ".js", ".jsx", ".ts", ".tsx", ".py", ".java", ".cs", ".rs", ".cpp", ".c", ".h"}
-	for _, supportedExt := range supportedExts {
-		if ext == supportedExt {
-			return true
-		}
-	}
-	return false
+// isSupportedFile 检查是否为支持的文件类型（以默认语言配置为准，结合文件名、模式行和 shebang 检测）
+func (u *IncrementalUpdater) isSupportedFile(filePath string) bool {
+	_, found := scanner.DetectFileLanguage(u.languages, filePath)
+	return found
 }
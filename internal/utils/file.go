@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"io"
+	"os"
+)
+
+// fileHeadSize 检测语言时读取的文件开头字节数
+const fileHeadSize = 4096
+
+// ReadFileHead 读取文件开头的内容（用于检测 shebang 和模式行），读取失败时返回空
+func ReadFileHead(filePath string) []byte {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	head, _ := io.ReadAll(io.LimitReader(file, fileHeadSize))
+	return head
+}